@@ -3,8 +3,13 @@ package aws
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -28,10 +33,97 @@ func sharedClientForRegion(region string) (interface{}, error) {
 	}
 
 	// configures a default client for the region, using the above env vars
-	client, err := conf.Client()
+	awsClient, err := conf.Client()
 	if err != nil {
 		return nil, fmt.Errorf("error getting AWS client")
 	}
 
-	return client, nil
+	if testSweepDryRun() {
+		for _, c := range testSweepServiceClients(awsClient.(*AWSClient)) {
+			c.Handlers.Validate.PushFrontNamed(testSweepRejectMutatingRequests)
+		}
+	}
+
+	return awsClient, nil
+}
+
+// testSweepDryRun returns whether sweepers should only log the resources they
+// would delete, which is enabled by setting the SWEEP_DRY_RUN environment
+// variable, e.g. make sweep SWEEP_DRY_RUN=1
+func testSweepDryRun() bool {
+	return os.Getenv("SWEEP_DRY_RUN") != ""
+}
+
+// testSweepServiceClients returns the API clients used by the sweepers, which
+// refuse mutating operations in a dry run in case a sweeper misses a check.
+func testSweepServiceClients(c *AWSClient) []*client.Client {
+	return []*client.Client{
+		c.apigateway.Client,
+		c.autoscalingconn.Client,
+		c.batchconn.Client,
+		c.cloudfrontconn.Client,
+		c.daxconn.Client,
+		c.dynamodbconn.Client,
+		c.ec2conn.Client,
+		c.elasticbeanstalkconn.Client,
+		c.elbconn.Client,
+		c.esconn.Client,
+		c.gameliftconn.Client,
+		c.iamconn.Client,
+		c.kmsconn.Client,
+		c.lambdaconn.Client,
+		c.mqconn.Client,
+		c.rdsconn.Client,
+		c.redshiftconn.Client,
+		c.wafconn.Client,
+		c.wafregionalconn.Client,
+	}
+}
+
+// testSweepRejectMutatingRequests fails every request for an operation which
+// isn't known to be read-only, before it is sent.
+var testSweepRejectMutatingRequests = request.NamedHandler{
+	Name: "terraform.TestSweepRejectMutatingRequestsHandler",
+	Fn: func(req *request.Request) {
+		if !testSweepIsReadOnlyOperation(req.Operation.Name) {
+			req.Error = fmt.Errorf("refusing to call %s %s during a sweeper dry run", req.ClientInfo.ServiceName, req.Operation.Name)
+		}
+	},
+}
+
+// testSweepIsReadOnlyOperation returns whether the named API operation only
+// reads resources, based on the verb the operation name starts with.
+func testSweepIsReadOnlyOperation(name string) bool {
+	for _, prefix := range []string{"Describe", "Get", "Head", "List"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTestSweepRejectMutatingRequests(t *testing.T) {
+	ec2Endpoints := []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=DescribeAccountAttributes&" +
+				"AttributeName.1=supported-platforms&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeAccountAttributes_response, "text/xml"},
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+	conn := ec2.New(sess)
+	conn.Handlers.Validate.PushFrontNamed(testSweepRejectMutatingRequests)
+
+	if _, err := GetSupportedEC2Platforms(conn); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	_, err = conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-12345678")})
+	if err == nil || !strings.Contains(err.Error(), "dry run") {
+		t.Fatalf("Expected dry run error, received: %v", err)
+	}
 }
@@ -1,7 +1,10 @@
 package aws
 
 import (
+	"errors"
+	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,40 +18,116 @@ func isAWSErr(err error, code string, message string) bool {
 	return false
 }
 
-func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
+// retryOnAwsErrors calls f until it succeeds, fails with an error rejected by
+// retryable, or the timeout elapses, and returns the last result of f along
+// with the final error.
+func retryOnAwsErrors(timeout time.Duration, retryable func(error) bool, f func() (interface{}, error)) (interface{}, error) {
+	// resource.Retry may return on timeout while f is still running in its
+	// own goroutine, so the last result is guarded by a mutex.
 	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
+	var respMu sync.Mutex
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		out, err := f()
+
+		respMu.Lock()
+		resp = out
+		respMu.Unlock()
+
 		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok && awsErr.Code() == code {
+			if retryable(err) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
 		}
 		return nil
 	})
+
+	respMu.Lock()
+	defer respMu.Unlock()
+
 	return resp, err
 }
 
+func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
+	return retryOnAwsCodes([]string{code}, f)
+}
+
 func retryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok {
-				for _, code := range codes {
-					if awsErr.Code() == code {
-						return resource.RetryableError(err)
-					}
-				}
+	return retryOnAwsErrors(1*time.Minute, func(err error) bool {
+		for _, code := range codes {
+			if isAWSErr(err, code, "") {
+				return true
 			}
-			return resource.NonRetryableError(err)
 		}
-		return nil
+		return false
+	}, f)
+}
+
+// iamPropagationTimeout is the default amount of time to retry operations
+// which fail while a newly created or updated IAM role or policy propagates.
+const iamPropagationTimeout = 2 * time.Minute
+
+// awsErrMatcher matches an AWS error by code and message substring, as in
+// isAWSErr.
+type awsErrMatcher struct {
+	Code    string
+	Message string
+}
+
+// retryOnIamPropagation calls f until it succeeds, fails with an error not
+// matching any of the given matchers, or the timeout elapses. It is used for
+// API calls referencing IAM roles that may not have propagated yet.
+func retryOnIamPropagation(timeout time.Duration, matchers []awsErrMatcher, f func() (interface{}, error)) (interface{}, error) {
+	return retryOnAwsErrors(timeout, func(err error) bool {
+		for _, m := range matchers {
+			if isAWSErr(err, m.Code, m.Message) {
+				log.Printf("[DEBUG] Retrying due to IAM eventual consistency: %s", err)
+				return true
+			}
+		}
+		return false
+	}, f)
+}
+
+// s3ReadAfterWriteTimeout is the default amount of time to retry reads of S3
+// bucket configuration which may return stale results right after a write.
+const s3ReadAfterWriteTimeout = 2 * time.Minute
+
+// errS3StaleRead is used internally to signal that a read after write
+// returned a result which does not yet reflect the write.
+var errS3StaleRead = errors.New("S3 read returned a stale result")
+
+// retryOnS3ReadAfterWrite calls read until it returns a result accepted by
+// consistent, backing off between attempts. Errors matching one of codes
+// (typically the "not found" code of a configuration which was just written)
+// and results rejected by consistent are retried until the timeout elapses. A
+// nil consistent accepts any successful result.
+//
+// If the result is still rejected once the timeout elapses, the last result is
+// returned without error so that callers can proceed and surface the actual
+// remote value rather than failing the apply.
+func retryOnS3ReadAfterWrite(timeout time.Duration, codes []string, read func() (interface{}, error), consistent func(interface{}) bool) (interface{}, error) {
+	resp, err := retryOnAwsErrors(timeout, func(err error) bool {
+		if err == errS3StaleRead {
+			return true
+		}
+		for _, code := range codes {
+			if isAWSErr(err, code, "") {
+				return true
+			}
+		}
+		return false
+	}, func() (interface{}, error) {
+		out, err := read()
+		if err == nil && consistent != nil && !consistent(out) {
+			return out, errS3StaleRead
+		}
+		return out, err
 	})
+	if err == errS3StaleRead {
+		log.Printf("[WARN] S3 read still stale after %s, continuing with last result", timeout)
+		return resp, nil
+	}
 	return resp, err
 }
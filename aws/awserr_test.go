@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestRetryOnIamPropagation(t *testing.T) {
+	matchers := []awsErrMatcher{
+		{"InvalidParameterValueException", "cannot be assumed"},
+	}
+
+	t.Run("retries matching errors", func(t *testing.T) {
+		calls := 0
+		out, err := retryOnIamPropagation(1*time.Minute, matchers, func() (interface{}, error) {
+			calls++
+			if calls < 2 {
+				return nil, awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil)
+			}
+			return "created", nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if out != "created" {
+			t.Fatalf("expected %q, got %v", "created", out)
+		}
+		if calls != 2 {
+			t.Fatalf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry other messages", func(t *testing.T) {
+		calls := 0
+		_, err := retryOnIamPropagation(1*time.Minute, matchers, func() (interface{}, error) {
+			calls++
+			return nil, awserr.New("InvalidParameterValueException", "Unsupported runtime.", nil)
+		})
+		if !isAWSErr(err, "InvalidParameterValueException", "Unsupported runtime") {
+			t.Fatalf("expected InvalidParameterValueException, got %v", err)
+		}
+		if calls != 1 {
+			t.Fatalf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		calls := 0
+		_, err := retryOnIamPropagation(1*time.Minute, matchers, func() (interface{}, error) {
+			calls++
+			return nil, errors.New("boom")
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if calls != 1 {
+			t.Fatalf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("gives up after the timeout", func(t *testing.T) {
+		_, err := retryOnIamPropagation(1*time.Second, matchers, func() (interface{}, error) {
+			return nil, awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil)
+		})
+		if !isAWSErr(err, "InvalidParameterValueException", "cannot be assumed") {
+			t.Fatalf("expected InvalidParameterValueException, got %v", err)
+		}
+	})
+}
+
+func TestRetryOnS3ReadAfterWrite(t *testing.T) {
+	t.Run("retries not found codes", func(t *testing.T) {
+		calls := 0
+		out, err := retryOnS3ReadAfterWrite(1*time.Minute, []string{"NoSuchConfiguration"}, func() (interface{}, error) {
+			calls++
+			if calls < 2 {
+				return nil, awserr.New("NoSuchConfiguration", "The specified configuration does not exist.", nil)
+			}
+			return "found", nil
+		}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if out != "found" {
+			t.Fatalf("expected %q, got %v", "found", out)
+		}
+		if calls != 2 {
+			t.Fatalf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("retries stale results", func(t *testing.T) {
+		calls := 0
+		out, err := retryOnS3ReadAfterWrite(1*time.Minute, nil, func() (interface{}, error) {
+			calls++
+			return calls, nil
+		}, func(v interface{}) bool {
+			return v.(int) >= 2
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if out != 2 {
+			t.Fatalf("expected 2, got %v", out)
+		}
+	})
+
+	t.Run("returns last result when still stale", func(t *testing.T) {
+		out, err := retryOnS3ReadAfterWrite(1*time.Second, nil, func() (interface{}, error) {
+			return "stale", nil
+		}, func(v interface{}) bool {
+			return false
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if out != "stale" {
+			t.Fatalf("expected %q, got %v", "stale", out)
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		calls := 0
+		_, err := retryOnS3ReadAfterWrite(1*time.Minute, []string{"NoSuchConfiguration"}, func() (interface{}, error) {
+			calls++
+			return nil, errors.New("boom")
+		}, nil)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if calls != 1 {
+			t.Fatalf("expected 1 call, got %d", calls)
+		}
+	})
+}
@@ -180,6 +180,7 @@ type AWSClient struct {
 	redshiftconn          *redshift.Redshift
 	r53conn               *route53.Route53
 	partition             string
+	dnsSuffix             string
 	accountid             string
 	supportedplatforms    []string
 	region                string
@@ -227,6 +228,15 @@ func (c *AWSClient) DynamoDB() *dynamodb.DynamoDB {
 	return c.dynamodbconn
 }
 
+// dnsSuffixForPartition returns the DNS suffix used by endpoints in the given
+// partition, as the SDK doesn't expose it.
+func dnsSuffixForPartition(partition string) string {
+	if partition == endpoints.AwsCnPartitionID {
+		return "amazonaws.com.cn"
+	}
+	return "amazonaws.com"
+}
+
 func (c *AWSClient) IsGovCloud() bool {
 	_, isGovCloud := endpoints.PartitionForRegion([]endpoints.Partition{endpoints.AwsUsGovPartition()}, c.region)
 	return isGovCloud
@@ -380,6 +390,7 @@ func (c *Config) Client() (interface{}, error) {
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), client.region); ok {
 		client.partition = partition.ID()
 	}
+	client.dnsSuffix = dnsSuffixForPartition(client.partition)
 
 	if !c.SkipRequestingAccountId {
 		accountID, err := GetAccountID(client.iamconn, client.stsconn, cp.ProviderName)
@@ -41,6 +41,23 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestDnsSuffixForPartition(t *testing.T) {
+	testCases := []struct {
+		Partition string
+		Expected  string
+	}{
+		{Partition: "aws", Expected: "amazonaws.com"},
+		{Partition: "aws-cn", Expected: "amazonaws.com.cn"},
+		{Partition: "aws-us-gov", Expected: "amazonaws.com"},
+	}
+
+	for _, tc := range testCases {
+		if got := dnsSuffixForPartition(tc.Partition); got != tc.Expected {
+			t.Errorf("partition %q: expected DNS suffix %q, got %q", tc.Partition, tc.Expected, got)
+		}
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
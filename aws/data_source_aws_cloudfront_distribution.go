@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCloudFrontDistribution() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontDistributionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"alias"},
+			},
+			"alias": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"aliases": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"in_progress_validation_batches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	id := d.Get("id").(string)
+
+	if alias, ok := d.GetOk("alias"); ok {
+		var err error
+		id, err = findCloudFrontDistributionIdByAlias(conn, alias.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	if id == "" {
+		return fmt.Errorf("One of id or alias must be specified")
+	}
+
+	input := &cloudfront.GetDistributionInput{
+		Id: aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Reading CloudFront Distribution: %s", input)
+	resp, err := conn.GetDistribution(input)
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Distribution (%s): %s", id, err)
+	}
+
+	distribution := resp.Distribution
+	d.SetId(aws.StringValue(distribution.Id))
+	d.Set("arn", distribution.ARN)
+	d.Set("domain_name", distribution.DomainName)
+	d.Set("etag", resp.ETag)
+	d.Set("hosted_zone_id", cloudFrontRoute53ZoneID)
+	d.Set("in_progress_validation_batches", distribution.InProgressInvalidationBatches)
+	d.Set("last_modified_time", aws.TimeValue(distribution.LastModifiedTime).String())
+	d.Set("status", distribution.Status)
+
+	if config := distribution.DistributionConfig; config != nil {
+		d.Set("enabled", config.Enabled)
+
+		if config.Aliases != nil {
+			if err := d.Set("aliases", flattenStringList(config.Aliases.Items)); err != nil {
+				return fmt.Errorf("error setting aliases: %s", err)
+			}
+		}
+	}
+
+	tagResp, err := conn.ListTagsForResource(&cloudfront.ListTagsForResourceInput{
+		Resource: distribution.ARN,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapCloudFront(tagResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// findCloudFrontDistributionIdByAlias returns the ID of the distribution
+// serving the given alternate domain name (CNAME).
+func findCloudFrontDistributionIdByAlias(conn *cloudfront.CloudFront, alias string) (string, error) {
+	var id string
+
+	err := conn.ListDistributionsPages(&cloudfront.ListDistributionsInput{}, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+		if page.DistributionList == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.DistributionList.Items {
+			if summary.Aliases == nil {
+				continue
+			}
+
+			for _, a := range summary.Aliases.Items {
+				if aws.StringValue(a) == alias {
+					id = aws.StringValue(summary.Id)
+					return false
+				}
+			}
+		}
+
+		return !lastPage
+	})
+	if err != nil {
+		return "", fmt.Errorf("error listing CloudFront Distributions: %s", err)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("no CloudFront Distribution found with alias %q", alias)
+	}
+
+	return id, nil
+}
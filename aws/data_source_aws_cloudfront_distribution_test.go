@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceCloudFrontDistribution_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "aws_cloudfront_distribution.s3_distribution"
+	dataSourceByIdName := "data.aws_cloudfront_distribution.by_id"
+	dataSourceByAliasName := "data.aws_cloudfront_distribution.by_alias"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceCloudFrontDistributionConfig(ri),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "hosted_zone_id", resourceName, "hosted_zone_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByIdName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceByIdName, "aliases.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceByIdName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceByAliasName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByAliasName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttr(dataSourceByAliasName, "hosted_zone_id", "Z2FDTNDATAQYW2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceCloudFrontDistributionConfig(rInt int) string {
+	return fmt.Sprintf(testAccAWSCloudFrontDistributionS3Config, rInt, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig()) + `
+data "aws_cloudfront_distribution" "by_id" {
+  id = "${aws_cloudfront_distribution.s3_distribution.id}"
+}
+
+data "aws_cloudfront_distribution" "by_alias" {
+  alias = "${aws_cloudfront_distribution.s3_distribution.aliases[0]}"
+}
+`
+}
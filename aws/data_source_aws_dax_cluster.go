@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsDaxCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDaxClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToLower(val.(string))
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_factor": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_topic_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"maintenance_window": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"configuration_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	clusterName := strings.ToLower(d.Get("cluster_name").(string))
+
+	log.Printf("[DEBUG] Reading DAX Cluster: %s", clusterName)
+	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+		ClusterNames: []*string{aws.String(clusterName)},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading DAX Cluster (%s): %s", clusterName, err)
+	}
+
+	if len(resp.Clusters) != 1 {
+		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(resp.Clusters))
+	}
+
+	c := resp.Clusters[0]
+	d.SetId(aws.StringValue(c.ClusterName))
+
+	d.Set("arn", c.ClusterArn)
+	d.Set("cluster_name", c.ClusterName)
+	d.Set("description", c.Description)
+	d.Set("iam_role_arn", c.IamRoleArn)
+	d.Set("node_type", c.NodeType)
+	d.Set("replication_factor", c.TotalNodes)
+	d.Set("maintenance_window", c.PreferredMaintenanceWindow)
+	d.Set("subnet_group_name", c.SubnetGroup)
+
+	if err := d.Set("security_group_ids", flattenDaxSecurityGroupIds(c.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %s", err)
+	}
+
+	if c.ClusterDiscoveryEndpoint != nil {
+		d.Set("port", c.ClusterDiscoveryEndpoint.Port)
+		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.StringValue(c.ClusterDiscoveryEndpoint.Address), aws.Int64Value(c.ClusterDiscoveryEndpoint.Port)))
+		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+	}
+
+	if c.ParameterGroup != nil {
+		d.Set("parameter_group_name", c.ParameterGroup.ParameterGroupName)
+	}
+
+	notificationTopicArn := ""
+	if c.NotificationConfiguration != nil && aws.StringValue(c.NotificationConfiguration.TopicStatus) == "active" {
+		notificationTopicArn = aws.StringValue(c.NotificationConfiguration.TopicArn)
+	}
+	d.Set("notification_topic_arn", notificationTopicArn)
+
+	if err := setDaxClusterNodeData(d, c); err != nil {
+		return fmt.Errorf("error setting nodes: %s", err)
+	}
+
+	tagsResp, err := conn.ListTags(&dax.ListTagsInput{
+		ResourceName: c.ClusterArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for DAX Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", daxKeyValueTags(tagsResp.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsDaxCluster_basic(t *testing.T) {
+	rString := acctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+	dataSourceName := "data.aws_dax_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsDaxClusterConfig(rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_address", resourceName, "cluster_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration_endpoint", resourceName, "configuration_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_role_arn", resourceName, "iam_role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "node_type", resourceName, "node_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.#", resourceName, "nodes.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.0.address", resourceName, "nodes.0.address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "port", resourceName, "port"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_factor", resourceName, "replication_factor"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsDaxClusterConfig(rString string) string {
+	return testAccAWSDAXClusterConfig(rString) + `
+data "aws_dax_cluster" "test" {
+  cluster_name = "${aws_dax_cluster.test.cluster_name}"
+}
+`
+}
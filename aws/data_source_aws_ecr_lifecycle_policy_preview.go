@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEcrLifecyclePolicyPreview() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEcrLifecyclePolicyPreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJsonString,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiring_image_total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"applied_rule_priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_pushed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsEcrLifecyclePolicyPreviewRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrconn
+
+	repositoryName := d.Get("repository").(string)
+
+	startInput := &ecr.StartLifecyclePolicyPreviewInput{
+		RepositoryName:      aws.String(repositoryName),
+		LifecyclePolicyText: aws.String(d.Get("policy").(string)),
+	}
+	if v, ok := d.GetOk("registry_id"); ok {
+		startInput.RegistryId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting ECR Lifecycle Policy Preview: %s", startInput)
+	startOutput, err := conn.StartLifecyclePolicyPreview(startInput)
+	if err != nil {
+		return fmt.Errorf("error starting ECR Lifecycle Policy Preview for repository (%s): %s", repositoryName, err)
+	}
+
+	registryId := aws.StringValue(startOutput.RegistryId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecr.LifecyclePolicyPreviewStatusInProgress},
+		Target: []string{
+			ecr.LifecyclePolicyPreviewStatusComplete,
+			ecr.LifecyclePolicyPreviewStatusExpired,
+			ecr.LifecyclePolicyPreviewStatusFailed,
+		},
+		Refresh:    ecrLifecyclePolicyPreviewStateRefresh(conn, registryId, repositoryName),
+		Timeout:    5 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	statusRaw, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for ECR Lifecycle Policy Preview for repository (%s) to complete: %s", repositoryName, err)
+	}
+
+	preview := statusRaw.(*ecr.GetLifecyclePolicyPreviewOutput)
+	status := aws.StringValue(preview.Status)
+	if status != ecr.LifecyclePolicyPreviewStatusComplete {
+		return fmt.Errorf("ECR Lifecycle Policy Preview for repository (%s) is %s", repositoryName, status)
+	}
+
+	var results []map[string]interface{}
+	input := &ecr.GetLifecyclePolicyPreviewInput{
+		RegistryId:     aws.String(registryId),
+		RepositoryName: aws.String(repositoryName),
+	}
+	for {
+		output, err := conn.GetLifecyclePolicyPreview(input)
+		if err != nil {
+			return fmt.Errorf("error reading ECR Lifecycle Policy Preview for repository (%s): %s", repositoryName, err)
+		}
+
+		results = append(results, flattenEcrLifecyclePolicyPreviewResults(output.PreviewResults)...)
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", registryId, repositoryName))
+	d.Set("registry_id", registryId)
+	d.Set("status", status)
+	if preview.Summary != nil {
+		d.Set("expiring_image_total_count", preview.Summary.ExpiringImageTotalCount)
+	}
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting results: %s", err)
+	}
+
+	return nil
+}
+
+func ecrLifecyclePolicyPreviewStateRefresh(conn *ecr.ECR, registryId, repositoryName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetLifecyclePolicyPreview(&ecr.GetLifecyclePolicyPreviewInput{
+			RegistryId:     aws.String(registryId),
+			RepositoryName: aws.String(repositoryName),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func flattenEcrLifecyclePolicyPreviewResults(results []*ecr.LifecyclePolicyPreviewResult) []map[string]interface{} {
+	var out []map[string]interface{}
+
+	for _, result := range results {
+		m := map[string]interface{}{
+			"applied_rule_priority": int(aws.Int64Value(result.AppliedRulePriority)),
+			"image_digest":          aws.StringValue(result.ImageDigest),
+			"image_tags":            aws.StringValueSlice(result.ImageTags),
+		}
+		if result.Action != nil {
+			m["action_type"] = aws.StringValue(result.Action.Type)
+		}
+		if result.ImagePushedAt != nil {
+			m["image_pushed_at"] = aws.TimeValue(result.ImagePushedAt).Format(time.RFC3339)
+		}
+		out = append(out, m)
+	}
+
+	return out
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSEcrDataSource_lifecyclePolicyPreview(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsEcrLifecyclePolicyPreviewDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_ecr_lifecycle_policy_preview.test", "registry_id", "aws_ecr_repository.test", "registry_id"),
+					resource.TestCheckResourceAttr("data.aws_ecr_lifecycle_policy_preview.test", "status", "COMPLETE"),
+					resource.TestCheckResourceAttr("data.aws_ecr_lifecycle_policy_preview.test", "expiring_image_total_count", "0"),
+					resource.TestCheckResourceAttr("data.aws_ecr_lifecycle_policy_preview.test", "results.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEcrLifecyclePolicyPreviewDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = "%s"
+}
+
+data "aws_ecr_lifecycle_policy_preview" "test" {
+  repository = "${aws_ecr_repository.test.name}"
+
+  policy = <<EOF
+{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "description": "Expire images older than 14 days",
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 14
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
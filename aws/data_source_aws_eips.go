@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEips() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEipsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"tags":   tagsSchemaComputed(),
+
+			"allocation_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"public_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsEipsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeAddressesInput{}
+
+	if filters, ok := d.GetOk("filter"); ok {
+		req.Filters = append(req.Filters, buildAwsDataSourceFilters(filters.(*schema.Set))...)
+	}
+
+	if tags, ok := d.GetOk("tags"); ok {
+		req.Filters = append(req.Filters, buildEC2TagFilterList(
+			tagsFromMap(tags.(map[string]interface{})),
+		)...)
+	}
+
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EIPs: %s", req)
+	resp, err := conn.DescribeAddresses(req)
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Addresses: %s", err)
+	}
+
+	allocationIds := make([]string, 0)
+	publicIps := make([]string, 0)
+
+	if resp != nil {
+		for _, address := range resp.Addresses {
+			// EC2-Classic addresses have no allocation ID.
+			if aws.StringValue(address.Domain) == ec2.DomainTypeVpc {
+				allocationIds = append(allocationIds, aws.StringValue(address.AllocationId))
+			}
+			publicIps = append(publicIps, aws.StringValue(address.PublicIp))
+		}
+	}
+
+	log.Printf("[DEBUG] Found %d EIPs via given filter", len(publicIps))
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("allocation_ids", allocationIds); err != nil {
+		return fmt.Errorf("error setting allocation_ids: %s", err)
+	}
+
+	if err := d.Set("public_ips", publicIps); err != nil {
+		return fmt.Errorf("error setting public_ips: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsEips_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEipsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_eips.by_tags", "allocation_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_eips.by_tags", "public_ips.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_eips.by_filter", "allocation_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_eips.by_filter", "allocation_ids.0", "aws_eip.test1", "id"),
+					resource.TestCheckResourceAttrPair("data.aws_eips.by_filter", "public_ips.0", "aws_eip.test1", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsEipsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_eip" "test1" {
+  vpc = true
+
+  tags {
+    Name  = "%[1]s-1"
+    Group = "%[1]s"
+  }
+}
+
+resource "aws_eip" "test2" {
+  vpc = true
+
+  tags {
+    Name  = "%[1]s-2"
+    Group = "%[1]s"
+  }
+}
+
+data "aws_eips" "by_tags" {
+  tags {
+    Group = "%[1]s"
+  }
+
+  depends_on = ["aws_eip.test1", "aws_eip.test2"]
+}
+
+data "aws_eips" "by_filter" {
+  filter {
+    name   = "tag:Name"
+    values = ["%[1]s-1"]
+  }
+
+  depends_on = ["aws_eip.test1", "aws_eip.test2"]
+}
+`, rName)
+}
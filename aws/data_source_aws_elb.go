@@ -15,7 +15,8 @@ func dataSourceAwsElb() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"access_logs": {
@@ -193,20 +194,67 @@ func dataSourceAwsElb() *schema.Resource {
 func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 	lbName := d.Get("name").(string)
+	tags := d.Get("tags").(map[string]interface{})
 
-	input := &elb.DescribeLoadBalancersInput{
-		LoadBalancerNames: []*string{aws.String(lbName)},
+	input := &elb.DescribeLoadBalancersInput{}
+	if lbName != "" {
+		input.LoadBalancerNames = []*string{aws.String(lbName)}
 	}
 
 	log.Printf("[DEBUG] Reading ELB: %s", input)
-	resp, err := elbconn.DescribeLoadBalancers(input)
+	var results []*elb.LoadBalancerDescription
+	err := elbconn.DescribeLoadBalancersPages(input, func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+		results = append(results, page.LoadBalancerDescriptions...)
+		return !lastPage
+	})
 	if err != nil {
 		return fmt.Errorf("Error retrieving LB: %s", err)
 	}
-	if len(resp.LoadBalancerDescriptions) != 1 {
-		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(resp.LoadBalancerDescriptions))
+
+	if len(tags) > 0 {
+		results, err = filterElbsByTags(elbconn, results, tags)
+		if err != nil {
+			return fmt.Errorf("Error retrieving LB tags: %s", err)
+		}
+	}
+
+	if len(results) != 1 {
+		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(results))
+	}
+	d.SetId(*results[0].LoadBalancerName)
+
+	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, results[0])
+}
+
+// filterElbsByTags returns the load balancers which have all of the given tags.
+func filterElbsByTags(conn *elb.ELB, lbs []*elb.LoadBalancerDescription, tags map[string]interface{}) ([]*elb.LoadBalancerDescription, error) {
+	lbsByName := make(map[string]*elb.LoadBalancerDescription, len(lbs))
+	names := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		lbsByName[aws.StringValue(lb.LoadBalancerName)] = lb
+		names = append(names, aws.StringValue(lb.LoadBalancerName))
+	}
+
+	matched, err := filterLoadBalancersByTags(names, tags, func(names []string) (map[string]map[string]string, error) {
+		resp, err := conn.DescribeTags(&elb.DescribeTagsInput{
+			LoadBalancerNames: aws.StringSlice(names),
+		})
+		if err != nil {
+			return nil, err
+		}
+		lbTags := make(map[string]map[string]string, len(resp.TagDescriptions))
+		for _, td := range resp.TagDescriptions {
+			lbTags[aws.StringValue(td.LoadBalancerName)] = tagsToMapELB(td.Tags)
+		}
+		return lbTags, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	d.SetId(*resp.LoadBalancerDescriptions[0].LoadBalancerName)
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, resp.LoadBalancerDescriptions[0])
+	results := make([]*elb.LoadBalancerDescription, 0, len(matched))
+	for _, name := range matched {
+		results = append(results, lbsByName[name])
+	}
+	return results, nil
 }
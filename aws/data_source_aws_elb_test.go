@@ -35,6 +35,27 @@ func TestAccDataSourceAWSELB_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAWSELB_tags(t *testing.T) {
+	// Must be less than 32 characters for ELB name
+	rName := fmt.Sprintf("TestAccDataSourceAWSELB-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSELBConfigTags(rName, t.Name()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_elb.test", "name", rName),
+					resource.TestCheckResourceAttr("data.aws_elb.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("data.aws_elb.test", "tags.Name", rName),
+					resource.TestCheckResourceAttr("data.aws_elb.test", "tags.TestName", t.Name()),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAWSELBConfigBasic(rName, testName string) string {
 	return fmt.Sprintf(`
 resource "aws_elb" "elb_test" {
@@ -112,3 +133,63 @@ data "aws_elb" "elb_test" {
 	name = "${aws_elb.elb_test.name}"
 }`, rName, testName)
 }
+
+func testAccDataSourceAWSELBConfigTags(rName, testName string) string {
+	return fmt.Sprintf(`
+resource "aws_elb" "test" {
+  name            = "%[1]s"
+  internal        = true
+  security_groups = ["${aws_security_group.test.id}"]
+  subnets         = ["${aws_subnet.test.*.id}"]
+
+  listener {
+    instance_port     = 80
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+
+  tags {
+    Name     = "%[1]s"
+    TestName = "%[2]s"
+  }
+}
+
+variable "subnets" {
+  default = ["10.0.1.0/24", "10.0.2.0/24"]
+  type    = "list"
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-elb-data-source-tags"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "${element(var.subnets, count.index)}"
+  availability_zone = "${element(data.aws_availability_zones.available.names, count.index)}"
+
+  tags {
+    Name = "tf-acc-elb-data-source-tags"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name        = "%[1]s"
+  description = "%[2]s"
+  vpc_id      = "${aws_vpc.test.id}"
+}
+
+data "aws_elb" "test" {
+  tags {
+    Name = "${aws_elb.test.name}"
+  }
+}`, rName, testName)
+}
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsGuarddutyDetector() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsGuarddutyDetectorRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsGuarddutyDetectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorId := d.Get("id").(string)
+
+	if detectorId == "" {
+		var detectorIds []string
+
+		log.Printf("[DEBUG] Listing GuardDuty Detectors")
+		err := conn.ListDetectorsPages(&guardduty.ListDetectorsInput{}, func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
+			for _, id := range page.DetectorIds {
+				detectorIds = append(detectorIds, aws.StringValue(id))
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("error listing GuardDuty Detectors: %s", err)
+		}
+
+		if len(detectorIds) == 0 {
+			return fmt.Errorf("no GuardDuty Detector found in the current region")
+		}
+		if len(detectorIds) > 1 {
+			return fmt.Errorf("multiple GuardDuty Detectors found in the current region, please specify an id")
+		}
+
+		detectorId = detectorIds[0]
+	}
+
+	input := guardduty.GetDetectorInput{
+		DetectorId: aws.String(detectorId),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty Detector: %s", input)
+	gdo, err := conn.GetDetector(&input)
+	if err != nil {
+		return fmt.Errorf("error reading GuardDuty Detector (%s): %s", detectorId, err)
+	}
+
+	d.SetId(detectorId)
+	d.Set("status", gdo.Status)
+	d.Set("service_role_arn", gdo.ServiceRole)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccAwsGuardDutyDetectorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsGuardDutyDetectorBasicResourceConfig,
+			},
+			{
+				Config: testAccAwsGuardDutyDetectorBasicResourceDataConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_guardduty_detector.test", "id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_guardduty_detector.test", "status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("data.aws_guardduty_detector.test", "service_role_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsGuardDutyDetectorDataSource_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsGuardDutyDetectorExplicitConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_guardduty_detector.test", "id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_guardduty_detector.test", "status", "ENABLED"),
+					resource.TestCheckResourceAttrSet("data.aws_guardduty_detector.test", "service_role_arn"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsGuardDutyDetectorBasicResourceConfig = `
+resource "aws_guardduty_detector" "test" {}
+`
+
+const testAccAwsGuardDutyDetectorBasicResourceDataConfig = `
+resource "aws_guardduty_detector" "test" {}
+
+data "aws_guardduty_detector" "test" {}
+`
+
+const testAccAwsGuardDutyDetectorExplicitConfig = `
+resource "aws_guardduty_detector" "test" {}
+
+data "aws_guardduty_detector" "test" {
+  id = "${aws_guardduty_detector.test.id}"
+}
+`
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsLambdaInvocation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test-lambda-invocation")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLambdaInvocationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.invocation_test", "result_map.%", "3"),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.invocation_test", "result_map.key1", "value1"),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.invocation_test", "result_map.key2", "value2"),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.invocation_test", "result_map.key3", "value3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsLambdaInvocation_qualifier(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test-lambda-invocation")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLambdaInvocationConfig_qualifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.invocation_test", "result_map.key1", "value1"),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.invocation_test", "result_map.key3", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsLambdaInvocationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "lambda_role" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "lambda_role_policy" {
+  role       = "${aws_iam_role.lambda_role.name}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"
+}
+`, rName)
+}
+
+func testAccDataSourceAwsLambdaInvocationConfig_basic(rName string) string {
+	return testAccDataSourceAwsLambdaInvocationConfig_base(rName) + fmt.Sprintf(`
+resource "aws_lambda_function" "lambda" {
+  depends_on = ["aws_iam_role_policy_attachment.lambda_role_policy"]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = "%s"
+  role          = "${aws_iam_role.lambda_role.arn}"
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs8.10"
+}
+
+data "aws_lambda_invocation" "invocation_test" {
+  function_name = "${aws_lambda_function.lambda.function_name}"
+
+  input = <<JSON
+{
+  "key1": "value1",
+  "key2": "value2",
+  "key3": "value3"
+}
+JSON
+}
+`, rName)
+}
+
+func testAccDataSourceAwsLambdaInvocationConfig_qualifier(rName string) string {
+	return testAccDataSourceAwsLambdaInvocationConfig_base(rName) + fmt.Sprintf(`
+resource "aws_lambda_function" "lambda" {
+  depends_on = ["aws_iam_role_policy_attachment.lambda_role_policy"]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = "%s"
+  role          = "${aws_iam_role.lambda_role.arn}"
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs8.10"
+  publish       = true
+
+  environment {
+    variables {
+      TEST_DATA = "%s"
+    }
+  }
+}
+
+data "aws_lambda_invocation" "invocation_test" {
+  function_name = "${aws_lambda_function.lambda.function_name}"
+  qualifier     = "${aws_lambda_function.lambda.version}"
+
+  input = <<JSON
+{
+  "key1": "value1",
+  "key2": "value2"
+}
+JSON
+}
+`, rName, rName)
+}
@@ -128,6 +128,7 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 	lbArn := d.Get("arn").(string)
 	lbName := d.Get("name").(string)
+	tags := d.Get("tags").(map[string]interface{})
 
 	describeLbOpts := &elbv2.DescribeLoadBalancersInput{}
 	switch {
@@ -138,14 +139,96 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Reading Load Balancer: %s", describeLbOpts)
-	describeResp, err := elbconn.DescribeLoadBalancers(describeLbOpts)
+	var results []*elbv2.LoadBalancer
+	err := elbconn.DescribeLoadBalancersPages(describeLbOpts, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		results = append(results, page.LoadBalancers...)
+		return !lastPage
+	})
 	if err != nil {
 		return errwrap.Wrapf("Error retrieving LB: {{err}}", err)
 	}
-	if len(describeResp.LoadBalancers) != 1 {
-		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(describeResp.LoadBalancers))
+
+	if len(tags) > 0 {
+		results, err = filterLbsByTags(elbconn, results, tags)
+		if err != nil {
+			return errwrap.Wrapf("Error retrieving LB tags: {{err}}", err)
+		}
+	}
+
+	if len(results) != 1 {
+		return fmt.Errorf("Search returned %d results, please revise so only one is returned", len(results))
+	}
+	d.SetId(*results[0].LoadBalancerArn)
+
+	return flattenAwsLbResource(d, meta, results[0])
+}
+
+// filterLbsByTags returns the load balancers which have all of the given tags.
+func filterLbsByTags(conn *elbv2.ELBV2, lbs []*elbv2.LoadBalancer, tags map[string]interface{}) ([]*elbv2.LoadBalancer, error) {
+	lbsByArn := make(map[string]*elbv2.LoadBalancer, len(lbs))
+	arns := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		lbsByArn[aws.StringValue(lb.LoadBalancerArn)] = lb
+		arns = append(arns, aws.StringValue(lb.LoadBalancerArn))
+	}
+
+	matched, err := filterLoadBalancersByTags(arns, tags, func(arns []string) (map[string]map[string]string, error) {
+		resp, err := conn.DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: aws.StringSlice(arns),
+		})
+		if err != nil {
+			return nil, err
+		}
+		lbTags := make(map[string]map[string]string, len(resp.TagDescriptions))
+		for _, td := range resp.TagDescriptions {
+			lbTags[aws.StringValue(td.ResourceArn)] = tagsToMapELBv2(td.Tags)
+		}
+		return lbTags, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*elbv2.LoadBalancer, 0, len(matched))
+	for _, arn := range matched {
+		results = append(results, lbsByArn[arn])
+	}
+	return results, nil
+}
+
+// filterLoadBalancersByTags returns the IDs (names or ARNs) of the load
+// balancers which have all of the given tags. describeTags returns the tags of
+// each of the given load balancers, keyed by ID; it is called with at most 20
+// IDs, the limit of both the ELB and ELBv2 DescribeTags APIs.
+func filterLoadBalancersByTags(ids []string, tags map[string]interface{}, describeTags func([]string) (map[string]map[string]string, error)) ([]string, error) {
+	const maxIdsPerCall = 20
+
+	var results []string
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > maxIdsPerCall {
+			n = maxIdsPerCall
+		}
+
+		lbTags, err := describeTags(ids[:n])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids[:n] {
+			matched := true
+			for k, v := range tags {
+				if lbValue, ok := lbTags[id][k]; !ok || lbValue != v.(string) {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				results = append(results, id)
+			}
+		}
+		ids = ids[n:]
 	}
-	d.SetId(*describeResp.LoadBalancers[0].LoadBalancerArn)
 
-	return flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0])
+	return results, nil
 }
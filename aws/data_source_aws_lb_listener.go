@@ -24,6 +24,7 @@ func dataSourceAwsLbListener() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"arn"},
+				ValidateFunc:  validateArnServiceResource("elasticloadbalancing", "loadbalancer/"),
 			},
 			"port": {
 				Type:          schema.TypeInt,
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -48,6 +49,27 @@ func TestAccDataSourceAWSLB_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAWSLB_tags(t *testing.T) {
+	lbName := fmt.Sprintf("testaccawslb-tags-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSLBConfigTags(lbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_lb.test", "arn", "aws_lb.test", "arn"),
+					resource.TestCheckResourceAttr("data.aws_lb.test", "name", lbName),
+					resource.TestCheckResourceAttr("data.aws_lb.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("data.aws_lb.test", "tags.Name", lbName),
+					resource.TestCheckResourceAttr("data.aws_lb.test", "tags.TestName", "TestAccDataSourceAWSLB_tags"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceAWSLBBackwardsCompatibility(t *testing.T) {
 	lbName := fmt.Sprintf("testaccawsalb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
@@ -163,6 +185,59 @@ data "aws_lb" "alb_test_with_name" {
 }`, lbName)
 }
 
+func testAccDataSourceAWSLBConfigTags(lbName string) string {
+	return fmt.Sprintf(`resource "aws_lb" "test" {
+  name            = "%[1]s"
+  internal        = true
+  security_groups = ["${aws_security_group.test.id}"]
+  subnets         = ["${aws_subnet.test.*.id}"]
+
+  tags {
+    Name     = "%[1]s"
+    TestName = "TestAccDataSourceAWSLB_tags"
+  }
+}
+
+variable "subnets" {
+  default = ["10.0.1.0/24", "10.0.2.0/24"]
+  type    = "list"
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-data-source-tags"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count                   = 2
+  vpc_id                  = "${aws_vpc.test.id}"
+  cidr_block              = "${element(var.subnets, count.index)}"
+  map_public_ip_on_launch = true
+  availability_zone       = "${element(data.aws_availability_zones.available.names, count.index)}"
+
+  tags {
+    Name = "tf-acc-lb-data-source-tags"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name        = "%[1]s"
+  description = "Used for LB data source testing"
+  vpc_id      = "${aws_vpc.test.id}"
+}
+
+data "aws_lb" "test" {
+  tags {
+    Name = "${aws_lb.test.name}"
+  }
+}`, lbName)
+}
+
 func testAccDataSourceAWSLBConfigBackardsCompatibility(albName string) string {
 	return fmt.Sprintf(`resource "aws_alb" "alb_test" {
   name            = "%s"
@@ -237,3 +312,42 @@ data "aws_alb" "alb_test_with_name" {
 	name = "${aws_alb.alb_test.name}"
 }`, albName)
 }
+
+func TestFilterLoadBalancersByTags(t *testing.T) {
+	var ids []string
+	for i := 0; i < 45; i++ {
+		ids = append(ids, fmt.Sprintf("lb-%d", i))
+	}
+
+	var calls int
+	matched, err := filterLoadBalancersByTags(ids, map[string]interface{}{"Env": "test", "Team": "a"}, func(ids []string) (map[string]map[string]string, error) {
+		calls++
+		if len(ids) > 20 {
+			t.Fatalf("describeTags called with %d IDs", len(ids))
+		}
+		tags := make(map[string]map[string]string, len(ids))
+		for _, id := range ids {
+			switch id {
+			case "lb-3", "lb-44":
+				tags[id] = map[string]string{"Env": "test", "Team": "a", "Name": id}
+			case "lb-21":
+				tags[id] = map[string]string{"Env": "test", "Team": "b"}
+			case "lb-30":
+				tags[id] = map[string]string{"Env": "test"}
+			default:
+				tags[id] = map[string]string{}
+			}
+		}
+		return tags, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 calls to describeTags, got %d", calls)
+	}
+	if expected := []string{"lb-3", "lb-44"}; !reflect.DeepEqual(matched, expected) {
+		t.Errorf("expected %v, got %v", expected, matched)
+	}
+}
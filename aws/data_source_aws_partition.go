@@ -16,6 +16,11 @@ func dataSourceAwsPartition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -29,5 +34,8 @@ func dataSourceAwsPartitionRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Setting AWS Partition to %s.", client.partition)
 	d.Set("partition", meta.(*AWSClient).partition)
 
+	log.Printf("[DEBUG] Setting AWS DNS Suffix to %s.", client.dnsSuffix)
+	d.Set("dns_suffix", meta.(*AWSClient).dnsSuffix)
+
 	return nil
 }
@@ -17,6 +17,7 @@ func TestAccAWSPartition_basic(t *testing.T) {
 				Config: testAccCheckAwsPartitionConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsPartition("data.aws_partition.current"),
+					testAccCheckAwsDnsSuffix("data.aws_partition.current"),
 				),
 			},
 		},
@@ -39,6 +40,25 @@ func testAccCheckAwsPartition(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAwsDnsSuffix(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find resource: %s", n)
+		}
+
+		expected := "amazonaws.com"
+		if testAccProvider.Meta().(*AWSClient).partition == "aws-cn" {
+			expected = "amazonaws.com.cn"
+		}
+		if rs.Primary.Attributes["dns_suffix"] != expected {
+			return fmt.Errorf("Incorrect DNS Suffix: expected %q, got %q", expected, rs.Primary.Attributes["dns_suffix"])
+		}
+
+		return nil
+	}
+}
+
 const testAccCheckAwsPartitionConfig_basic = `
 data "aws_partition" "current" { }
 `
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsRoute53DelegationSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRoute53DelegationSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsRoute53DelegationSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	id := cleanDelegationSetId(d.Get("id").(string))
+
+	input := &route53.GetReusableDelegationSetInput{
+		Id: aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Reading Route53 reusable delegation set: %s", input)
+	resp, err := conn.GetReusableDelegationSet(input)
+	if err != nil {
+		return fmt.Errorf("error reading Route53 reusable delegation set (%s): %s", id, err)
+	}
+
+	set := resp.DelegationSet
+
+	d.SetId(cleanDelegationSetId(aws.StringValue(set.Id)))
+	d.Set("caller_reference", set.CallerReference)
+
+	if err := d.Set("name_servers", expandNameServers(set.NameServers)); err != nil {
+		return fmt.Errorf("error setting name_servers: %s", err)
+	}
+
+	return nil
+}
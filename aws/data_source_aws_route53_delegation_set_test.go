@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceRoute53DelegationSet_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_delegation_set.dset"
+	resourceName := "aws_route53_delegation_set.dset"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceRoute53DelegationSetConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name_servers.#", "4"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name_servers.0", resourceName, "name_servers.0"),
+					resource.TestMatchResourceAttr(dataSourceName, "caller_reference", regexp.MustCompile("^DynDNS")),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSDataSourceRoute53DelegationSetConfig_basic = `
+resource "aws_route53_delegation_set" "dset" {
+  reference_name = "DynDNS"
+}
+
+data "aws_route53_delegation_set" "dset" {
+  id = "${aws_route53_delegation_set.dset.id}"
+}
+`
@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcPeeringConnections_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcPeeringConnectionsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpc_peering_connections.test_by_filters", "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpcPeeringConnectionsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-peering-connections-data-source-foo"
+  }
+}
+
+resource "aws_vpc" "bar" {
+  cidr_block = "10.2.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-peering-connections-data-source-bar"
+  }
+}
+
+resource "aws_vpc" "baz" {
+  cidr_block = "10.3.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-peering-connections-data-source-baz"
+  }
+}
+
+resource "aws_vpc_peering_connection" "foo_to_bar" {
+  vpc_id      = "${aws_vpc.foo.id}"
+  peer_vpc_id = "${aws_vpc.bar.id}"
+  auto_accept = true
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+
+resource "aws_vpc_peering_connection" "foo_to_baz" {
+  vpc_id      = "${aws_vpc.foo.id}"
+  peer_vpc_id = "${aws_vpc.baz.id}"
+  auto_accept = true
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+
+data "aws_vpc_peering_connections" "test_by_filters" {
+  filter {
+    name   = "vpc-peering-connection-id"
+    values = ["${aws_vpc_peering_connection.foo_to_bar.id}", "${aws_vpc_peering_connection.foo_to_baz.id}"]
+  }
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+`, rName)
+}
@@ -1,9 +1,12 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAWSAPIGatewayApiKey_importBasic(t *testing.T) {
@@ -26,3 +29,65 @@ func TestAccAWSAPIGatewayApiKey_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAWSAPIGatewayApiKey_importByValue(t *testing.T) {
+	resourceName := "aws_api_gateway_api_key.custom"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayApiKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAPIGatewayApiKeyConfig,
+			},
+
+			resource.TestStep{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return rs.Primary.Attributes["value"], nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayApiKey_importByShortValue(t *testing.T) {
+	resourceName := "aws_api_gateway_api_key.test"
+	// Custom key values can be as short as 20 characters
+	value := acctest.RandString(20)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayApiKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAPIGatewayApiKeyConfigValue(value),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     value,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSAPIGatewayApiKeyConfigValue(value string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_api_key" "test" {
+  name  = "tf-acc-test-%[1]s"
+  value = "%[1]s"
+}
+`, value)
+}
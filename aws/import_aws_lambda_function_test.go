@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
@@ -26,38 +27,41 @@ func testSweepLambdaFunctions(region string) error {
 
 	lambdaconn := client.(*AWSClient).lambdaconn
 
-	resp, err := lambdaconn.ListFunctions(&lambda.ListFunctionsInput{})
-	if err != nil {
-		return fmt.Errorf("Error retrieving Lambda functions: %s", err)
-	}
-
-	if len(resp.Functions) == 0 {
-		log.Print("[DEBUG] No aws lambda functions to sweep")
-		return nil
-	}
+	var errors error
+	err = lambdaconn.ListFunctionsPages(&lambda.ListFunctionsInput{}, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		for _, f := range page.Functions {
+			var testOptGroup bool
+			for _, testName := range []string{"tf_test", "tf_acc_"} {
+				if strings.HasPrefix(*f.FunctionName, testName) {
+					testOptGroup = true
+				}
+			}
 
-	for _, f := range resp.Functions {
-		var testOptGroup bool
-		for _, testName := range []string{"tf_test", "tf_acc_"} {
-			if strings.HasPrefix(*f.FunctionName, testName) {
-				testOptGroup = true
+			if !testOptGroup {
+				continue
 			}
-		}
 
-		if !testOptGroup {
-			continue
-		}
+			if testSweepDryRun() {
+				log.Printf("[INFO] Would delete Lambda function %s", *f.FunctionName)
+				continue
+			}
 
-		_, err := lambdaconn.DeleteFunction(
-			&lambda.DeleteFunctionInput{
-				FunctionName: f.FunctionName,
-			})
-		if err != nil {
-			return err
+			log.Printf("[INFO] Deleting Lambda function %s", *f.FunctionName)
+			_, err := lambdaconn.DeleteFunction(
+				&lambda.DeleteFunctionInput{
+					FunctionName: f.FunctionName,
+				})
+			if err != nil {
+				errors = multierror.Append(errors, fmt.Errorf("Error deleting Lambda function %s: %s", *f.FunctionName, err))
+			}
 		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving Lambda functions: %s", err)
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSLambdaFunction_importLocalFile(t *testing.T) {
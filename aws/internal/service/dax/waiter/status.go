@@ -0,0 +1,80 @@
+package waiter
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// ClusterStatusAvailable is reported once the cluster and all of its
+	// nodes are available.
+	ClusterStatusAvailable = "available"
+
+	ClusterStatusCreating  = "creating"
+	ClusterStatusDeleting  = "deleting"
+	ClusterStatusModifying = "modifying"
+)
+
+// ClusterStatus fetches the DAX cluster and its status. A nil cluster and
+// empty status are returned once the cluster no longer exists.
+func ClusterStatus(conn *dax.DAX, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
+			ClusterNames: []*string{aws.String(name)},
+		})
+
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dax.ErrCodeClusterNotFoundFault {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var cluster *dax.Cluster
+		for _, c := range resp.Clusters {
+			if aws.StringValue(c.ClusterName) == name {
+				cluster = c
+				break
+			}
+		}
+
+		if cluster == nil {
+			return nil, "", fmt.Errorf("no matching DAX cluster for name (%s)", name)
+		}
+
+		// DescribeClusters returns a response without status late on in the
+		// deletion process - assume cluster is still deleting until we
+		// get ClusterNotFoundFault
+		if cluster.Status == nil {
+			log.Printf("[DEBUG] DAX Cluster (%s) has no status attribute set - assume status is deleting", name)
+			return cluster, ClusterStatusDeleting, nil
+		}
+
+		status := aws.StringValue(cluster.Status)
+
+		// The cluster reports available before all of its nodes are, so
+		// treat it as still creating until the node count and node
+		// statuses catch up.
+		if status == ClusterStatusAvailable {
+			if int64(len(cluster.Nodes)) != aws.Int64Value(cluster.TotalNodes) {
+				log.Printf("[DEBUG] DAX Cluster (%s) node count is not what is expected: %d found, %d expected", name, len(cluster.Nodes), aws.Int64Value(cluster.TotalNodes))
+				return cluster, ClusterStatusCreating, nil
+			}
+
+			for _, n := range cluster.Nodes {
+				if n.NodeStatus != nil && aws.StringValue(n.NodeStatus) != ClusterStatusAvailable {
+					log.Printf("[DEBUG] DAX Cluster (%s) node (%s) is not yet available, status: %s", name, aws.StringValue(n.NodeId), aws.StringValue(n.NodeStatus))
+					return cluster, ClusterStatusCreating, nil
+				}
+			}
+		}
+
+		return cluster, status, nil
+	}
+}
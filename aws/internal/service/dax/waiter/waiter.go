@@ -0,0 +1,69 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for a Cluster to be created
+	ClusterCreatedTimeout = 45 * time.Minute
+
+	// Default maximum amount of time to wait for a Cluster to be updated
+	ClusterUpdatedTimeout = 90 * time.Minute
+
+	// Default maximum amount of time to wait for a Cluster to be deleted
+	ClusterDeletedTimeout = 45 * time.Minute
+
+	clusterStatusDelay      = 30 * time.Second
+	clusterStatusMinTimeout = 10 * time.Second
+)
+
+// ClusterAvailable waits for a Cluster and all of its nodes to become available.
+func ClusterAvailable(conn *dax.DAX, name string, timeout time.Duration) (*dax.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ClusterStatusCreating, ClusterStatusModifying},
+		Target:     []string{ClusterStatusAvailable},
+		Refresh:    ClusterStatus(conn, name),
+		Timeout:    timeout,
+		MinTimeout: clusterStatusMinTimeout,
+		Delay:      clusterStatusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dax.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClusterDeleted waits for a Cluster to be deleted.
+func ClusterDeleted(conn *dax.DAX, name string, timeout time.Duration) (*dax.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ClusterStatusCreating,
+			ClusterStatusAvailable,
+			ClusterStatusDeleting,
+			ClusterStatusModifying,
+			"incompatible-parameters",
+			"incompatible-network",
+		},
+		Target:     []string{},
+		Refresh:    ClusterStatus(conn, name),
+		Timeout:    timeout,
+		MinTimeout: clusterStatusMinTimeout,
+		Delay:      clusterStatusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dax.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
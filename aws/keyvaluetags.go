@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// awsTagKeyPrefix is the prefix of tag keys reserved for use by AWS.
+const awsTagKeyPrefix = "aws:"
+
+// keyValueTags is a standard implementation of AWS key-value resource tags.
+// Service-specific tag types are converted to and from keyValueTags so that
+// resources diff, ignore and update tags in the same way. Only the DAX and
+// Direct Connect resources use it so far; other services still use their
+// tags<Service>.go helpers until they are migrated.
+type keyValueTags map[string]*string
+
+// newKeyValueTags creates keyValueTags from common Terraform and AWS Go types:
+// a map[string]interface{} from the schema, a map[string]string or a
+// map[string]*string. Unknown types are returned as empty tags.
+func newKeyValueTags(i interface{}) keyValueTags {
+	switch value := i.(type) {
+	case keyValueTags:
+		return value
+	case map[string]*string:
+		return keyValueTags(value)
+	case map[string]string:
+		kvtm := make(keyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = aws.String(v)
+		}
+
+		return kvtm
+	case map[string]interface{}:
+		kvtm := make(keyValueTags, len(value))
+
+		for k, v := range value {
+			str := fmt.Sprintf("%v", v)
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	default:
+		return make(keyValueTags)
+	}
+}
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags keyValueTags) IgnoreAws() keyValueTags {
+	result := make(keyValueTags)
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, awsTagKeyPrefix) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Keys returns the tag keys.
+func (tags keyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// Map returns tag keys mapped to their values.
+func (tags keyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		result[k] = aws.StringValue(v)
+	}
+
+	return result
+}
+
+// Removed returns the tags that are not present in newTags.
+func (tags keyValueTags) Removed(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the tags in newTags that are new or have changed value.
+func (tags keyValueTags) Updated(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || aws.StringValue(oldV) != aws.StringValue(newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+)
+
+// This file contains the conversions between keyValueTags and the tag types
+// of individual services, along with the service tag update functions.
+// Resources should update tags with the <service>UpdateTags functions so
+// that removals and changes are handled consistently.
+
+// daxTags returns dax service tags.
+func (tags keyValueTags) daxTags() []*dax.Tag {
+	result := make([]*dax.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		result = append(result, &dax.Tag{
+			Key:   aws.String(k),
+			Value: v,
+		})
+	}
+
+	return result
+}
+
+// daxKeyValueTags creates keyValueTags from dax service tags.
+func daxKeyValueTags(tags []*dax.Tag) keyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return newKeyValueTags(m)
+}
+
+// daxUpdateTags updates dax service tags.
+// The identifier is typically the Amazon Resource Name (ARN).
+func daxUpdateTags(conn *dax.DAX, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &dax.UntagResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		if _, err := conn.UntagResource(input); err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &dax.TagResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.daxTags(),
+		}
+
+		if _, err := conn.TagResource(input); err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// directconnectListTags lists directconnect service tags.
+// The identifier is typically the Amazon Resource Name (ARN).
+func directconnectListTags(conn *directconnect.DirectConnect, identifier string) (keyValueTags, error) {
+	input := &directconnect.DescribeTagsInput{
+		ResourceArns: aws.StringSlice([]string{identifier}),
+	}
+
+	output, err := conn.DescribeTags(input)
+	if err != nil {
+		return newKeyValueTags(nil), fmt.Errorf("error listing tags for resource (%s): %s", identifier, err)
+	}
+
+	if len(output.ResourceTags) == 1 && aws.StringValue(output.ResourceTags[0].ResourceArn) == identifier {
+		return directconnectKeyValueTags(output.ResourceTags[0].Tags), nil
+	}
+
+	return newKeyValueTags(nil), nil
+}
+
+// directconnectTags returns directconnect service tags.
+func (tags keyValueTags) directconnectTags() []*directconnect.Tag {
+	result := make([]*directconnect.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		result = append(result, &directconnect.Tag{
+			Key:   aws.String(k),
+			Value: v,
+		})
+	}
+
+	return result
+}
+
+// directconnectKeyValueTags creates keyValueTags from directconnect service tags.
+func directconnectKeyValueTags(tags []*directconnect.Tag) keyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return newKeyValueTags(m)
+}
+
+// directconnectUpdateTags updates directconnect service tags.
+// The identifier is typically the Amazon Resource Name (ARN).
+func directconnectUpdateTags(conn *directconnect.DirectConnect, identifier string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &directconnect.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		if _, err := conn.UntagResource(input); err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &directconnect.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.directconnectTags(),
+		}
+
+		if _, err := conn.TagResource(input); err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
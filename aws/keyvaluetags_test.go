@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+)
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags keyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: newKeyValueTags(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: newKeyValueTags(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"aws:cloudformation:key2": "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: newKeyValueTags(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+				"key3-aws:":               "value3",
+			}),
+			want: map[string]string{
+				"key2":      "value2",
+				"key3-aws:": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreAws()
+
+			if !reflect.DeepEqual(got.Map(), testCase.want) {
+				t.Errorf("got %#v, want %#v", got.Map(), testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	tags := newKeyValueTags(map[string]interface{}{
+		"key1": "value1",
+		"key2": "value2",
+	})
+
+	got := tags.Keys()
+	sort.Strings(got)
+	want := []string{"key1", "key2"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestKeyValueTagsRemoved(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags keyValueTags
+		newTags keyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: newKeyValueTags(map[string]string{}),
+			newTags: newKeyValueTags(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all new",
+			oldTags: newKeyValueTags(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: newKeyValueTags(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "mixed",
+			oldTags: newKeyValueTags(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: newKeyValueTags(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Removed(testCase.newTags)
+
+			if !reflect.DeepEqual(got.Map(), testCase.want) {
+				t.Errorf("got %#v, want %#v", got.Map(), testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsUpdated(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags keyValueTags
+		newTags keyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: newKeyValueTags(map[string]string{}),
+			newTags: newKeyValueTags(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "no changes",
+			oldTags: newKeyValueTags(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: newKeyValueTags(map[string]interface{}{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			oldTags: newKeyValueTags(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: newKeyValueTags(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Updated(testCase.newTags)
+
+			if !reflect.DeepEqual(got.Map(), testCase.want) {
+				t.Errorf("got %#v, want %#v", got.Map(), testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsServiceDiff(t *testing.T) {
+	testCases := []struct {
+		name       string
+		oldTags    map[string]interface{}
+		newTags    map[string]interface{}
+		wantUpdate map[string]string
+		wantRemove map[string]string
+	}{
+		{
+			name: "add and remove",
+			oldTags: map[string]interface{}{
+				"foo": "bar",
+			},
+			newTags: map[string]interface{}{
+				"bar": "baz",
+			},
+			wantUpdate: map[string]string{
+				"bar": "baz",
+			},
+			wantRemove: map[string]string{
+				"foo": "bar",
+			},
+		},
+		{
+			name: "modify",
+			oldTags: map[string]interface{}{
+				"foo": "bar",
+			},
+			newTags: map[string]interface{}{
+				"foo": "baz",
+			},
+			wantUpdate: map[string]string{
+				"foo": "baz",
+			},
+			wantRemove: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run("dax "+testCase.name, func(t *testing.T) {
+			oldTags := daxKeyValueTags(newKeyValueTags(testCase.oldTags).daxTags())
+			newTags := daxKeyValueTags(newKeyValueTags(testCase.newTags).daxTags())
+
+			if got := oldTags.Updated(newTags).Map(); !reflect.DeepEqual(got, testCase.wantUpdate) {
+				t.Errorf("updated: got %#v, want %#v", got, testCase.wantUpdate)
+			}
+			if got := oldTags.Removed(newTags).Map(); !reflect.DeepEqual(got, testCase.wantRemove) {
+				t.Errorf("removed: got %#v, want %#v", got, testCase.wantRemove)
+			}
+		})
+
+		t.Run("directconnect "+testCase.name, func(t *testing.T) {
+			oldTags := directconnectKeyValueTags(newKeyValueTags(testCase.oldTags).directconnectTags())
+			newTags := directconnectKeyValueTags(newKeyValueTags(testCase.newTags).directconnectTags())
+
+			if got := oldTags.Updated(newTags).Map(); !reflect.DeepEqual(got, testCase.wantUpdate) {
+				t.Errorf("updated: got %#v, want %#v", got, testCase.wantUpdate)
+			}
+			if got := oldTags.Removed(newTags).Map(); !reflect.DeepEqual(got, testCase.wantRemove) {
+				t.Errorf("removed: got %#v, want %#v", got, testCase.wantRemove)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsServiceIgnoreAws(t *testing.T) {
+	daxTags := []*dax.Tag{
+		{
+			Key:   aws.String("aws:cloudformation:logical-id"),
+			Value: aws.String("foo"),
+		},
+		{
+			Key:   aws.String("aws:foo:bar"),
+			Value: aws.String("baz"),
+		},
+	}
+	if got := daxKeyValueTags(daxTags).IgnoreAws().Map(); len(got) != 0 {
+		t.Errorf("dax: got %#v, want no tags", got)
+	}
+
+	directconnectTags := []*directconnect.Tag{
+		{
+			Key:   aws.String("aws:cloudformation:logical-id"),
+			Value: aws.String("foo"),
+		},
+		{
+			Key:   aws.String("aws:foo:bar"),
+			Value: aws.String("baz"),
+		},
+	}
+	if got := directconnectKeyValueTags(directconnectTags).IgnoreAws().Map(); len(got) != 0 {
+		t.Errorf("directconnect: got %#v, want no tags", got)
+	}
+}
@@ -21,9 +21,10 @@ func resourceAwsAcmCertificateValidation() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"certificate_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAcmCertificateArn,
 			},
 			"validation_record_fqdns": {
 				Type:     schema.TypeSet,
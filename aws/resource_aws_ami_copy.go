@@ -36,7 +36,7 @@ func resourceAwsAmiCopy() *schema.Resource {
 		Optional:     true,
 		Computed:     true,
 		ForceNew:     true,
-		ValidateFunc: validateArn,
+		ValidateFunc: validateKmsKeyArn,
 	}
 
 	return &schema.Resource{
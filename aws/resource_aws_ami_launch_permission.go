@@ -17,6 +17,9 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 		Create: resourceAwsAmiLaunchPermissionCreate,
 		Read:   resourceAwsAmiLaunchPermissionRead,
 		Delete: resourceAwsAmiLaunchPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAmiLaunchPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"image_id": &schema.Schema{
@@ -64,7 +67,35 @@ func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsAmiLaunchPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The ID is "<image_id>-<account_id>"; account IDs never contain a hyphen.
+	idx := strings.LastIndex(d.Id(), "-")
+	if idx <= 0 || idx == len(d.Id())-1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected IMAGE_ID-ACCOUNT_ID", d.Id())
+	}
+
+	d.Set("image_id", d.Id()[:idx])
+	d.Set("account_id", d.Id()[idx+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAmiLaunchPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	image_id := d.Get("image_id").(string)
+	account_id := d.Get("account_id").(string)
+
+	exists, err := hasLaunchPermission(conn, image_id, account_id)
+	if err != nil {
+		return fmt.Errorf("error reading ami launch permission (%s): %s", d.Id(), err)
+	}
+	if !exists {
+		log.Printf("[WARN] AMI launch permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	return nil
 }
 
@@ -106,7 +137,7 @@ func hasLaunchPermission(conn *ec2.EC2, image_id string, account_id string) (boo
 	}
 
 	for _, lp := range attrs.LaunchPermissions {
-		if *lp.UserId == account_id {
+		if aws.StringValue(lp.UserId) == account_id {
 			return true, nil
 		}
 	}
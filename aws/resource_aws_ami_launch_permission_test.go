@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,6 +33,11 @@ func TestAccAWSAMILaunchPermission_Basic(t *testing.T) {
 					testAccAWSAMILaunchPermissionExists(accountID, &imageID),
 				),
 			},
+			r.TestStep{
+				ResourceName:      "aws_ami_launch_permission.self-test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Drop just launch permission to test destruction
 			r.TestStep{
 				Config: testAccAWSAMILaunchPermissionConfig(accountID, false),
@@ -39,6 +45,15 @@ func TestAccAWSAMILaunchPermission_Basic(t *testing.T) {
 					testAccAWSAMILaunchPermissionDestroyed(accountID, &imageID),
 				),
 			},
+			// Importing the now missing launch permission must fail
+			r.TestStep{
+				ResourceName: "aws_ami_launch_permission.self-test",
+				ImportState:  true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s-%s", imageID, accountID), nil
+				},
+				ExpectError: regexp.MustCompile(`You cannot import non-existent\s+resources`),
+			},
 			// Re-add everything so we can test when AMI disappears
 			r.TestStep{
 				Config: testAccAWSAMILaunchPermissionConfig(accountID, true),
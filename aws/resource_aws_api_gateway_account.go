@@ -3,11 +3,9 @@ package aws
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -23,8 +21,9 @@ func resourceAwsApiGatewayAccount() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cloudwatch_role_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"throttle_settings": &schema.Schema{
 				Type:     schema.TypeList,
@@ -93,23 +92,11 @@ func resourceAwsApiGatewayAccountUpdate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Updating API Gateway Account: %s", input)
 
 	// Retry due to eventual consistency of IAM
-	expectedErrMsg := "The role ARN does not have required permissions set to API Gateway"
-	otherErrMsg := "API Gateway could not successfully write to CloudWatch Logs using the ARN specified"
-	var out *apigateway.Account
-	var err error
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err = conn.UpdateAccount(&input)
-
-		if err != nil {
-			if isAWSErr(err, "BadRequestException", expectedErrMsg) ||
-				isAWSErr(err, "BadRequestException", otherErrMsg) {
-				log.Printf("[DEBUG] Retrying API Gateway Account update: %s", err)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	out, err := retryOnIamPropagation(iamPropagationTimeout, []awsErrMatcher{
+		{"BadRequestException", "The role ARN does not have required permissions set to API Gateway"},
+		{"BadRequestException", "API Gateway could not successfully write to CloudWatch Logs using the ARN specified"},
+	}, func() (interface{}, error) {
+		return conn.UpdateAccount(&input)
 	})
 	if err != nil {
 		return fmt.Errorf("Updating API Gateway Account failed: %s", err)
@@ -20,7 +20,7 @@ func resourceAwsApiGatewayApiKey() *schema.Resource {
 		Update: resourceAwsApiGatewayApiKeyUpdate,
 		Delete: resourceAwsApiGatewayApiKeyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsApiGatewayApiKeyImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,6 +42,11 @@ func resourceAwsApiGatewayApiKey() *schema.Resource {
 				Default:  true,
 			},
 
+			"customer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"stage_key": {
 				Type:       schema.TypeSet,
 				Optional:   true,
@@ -87,13 +92,19 @@ func resourceAwsApiGatewayApiKeyCreate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Creating API Gateway API Key")
 
-	apiKey, err := conn.CreateApiKey(&apigateway.CreateApiKeyInput{
+	input := &apigateway.CreateApiKeyInput{
 		Name:        aws.String(d.Get("name").(string)),
 		Description: aws.String(d.Get("description").(string)),
 		Enabled:     aws.Bool(d.Get("enabled").(bool)),
 		Value:       aws.String(d.Get("value").(string)),
 		StageKeys:   expandApiGatewayStageKeys(d),
-	})
+	}
+
+	if v, ok := d.GetOk("customer_id"); ok {
+		input.CustomerId = aws.String(v.(string))
+	}
+
+	apiKey, err := conn.CreateApiKey(input)
 	if err != nil {
 		return fmt.Errorf("Error creating API Gateway: %s", err)
 	}
@@ -124,6 +135,7 @@ func resourceAwsApiGatewayApiKeyRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("name", apiKey.Name)
 	d.Set("description", apiKey.Description)
 	d.Set("enabled", apiKey.Enabled)
+	d.Set("customer_id", apiKey.CustomerId)
 	d.Set("stage_key", flattenApiGatewayStageKeys(apiKey.StageKeys))
 	d.Set("value", apiKey.Value)
 
@@ -160,6 +172,14 @@ func resourceAwsApiGatewayApiKeyUpdateOperations(d *schema.ResourceData) []*apig
 		})
 	}
 
+	if d.HasChange("customer_id") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/customerId"),
+			Value: aws.String(d.Get("customer_id").(string)),
+		})
+	}
+
 	if d.HasChange("stage_key") {
 		operations = append(operations, expandApiGatewayStageKeyOperations(d)...)
 	}
@@ -202,3 +222,44 @@ func resourceAwsApiGatewayApiKeyDelete(d *schema.ResourceData, meta interface{})
 		return resource.NonRetryableError(err)
 	})
 }
+
+// resourceAwsApiGatewayApiKeyImport allows API keys to be imported either by
+// ID or by key value. Custom key values can be as short as IDs, so the ID is
+// tried first and the key values are only searched when no key has that ID.
+func resourceAwsApiGatewayApiKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).apigateway
+
+	_, err := conn.GetApiKey(&apigateway.GetApiKeyInput{
+		ApiKey: aws.String(d.Id()),
+	})
+	if err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	if !isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+		return nil, fmt.Errorf("error reading API Gateway API Key (%s): %s", d.Id(), err)
+	}
+
+	var id string
+	err = conn.GetApiKeysPages(&apigateway.GetApiKeysInput{
+		IncludeValues: aws.Bool(true),
+	}, func(page *apigateway.GetApiKeysOutput, lastPage bool) bool {
+		for _, apiKey := range page.Items {
+			if aws.StringValue(apiKey.Value) == d.Id() {
+				id = aws.StringValue(apiKey.Id)
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing API Gateway API Keys: %s", err)
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("no API Gateway API Key found with the given ID or value")
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -35,6 +35,8 @@ func TestAccAWSAPIGatewayApiKey_basic(t *testing.T) {
 						"aws_api_gateway_api_key.test", "last_updated_date"),
 					resource.TestCheckResourceAttr(
 						"aws_api_gateway_api_key.custom", "value", "MyCustomToken#@&\"'(§!ç)-_*$€¨^£%ù+=/:.;?,|"),
+					resource.TestCheckResourceAttr(
+						"aws_api_gateway_api_key.custom", "customer_id", "customer-123"),
 				),
 			},
 		},
@@ -183,6 +185,7 @@ resource "aws_api_gateway_api_key" "custom" {
   name = "bar"
   enabled = true
   value = "MyCustomToken#@&\"'(§!ç)-_*$€¨^£%ù+=/:.;?,|"
+  customer_id = "customer-123"
 
   stage_key {
     rest_api_id = "${aws_api_gateway_rest_api.test.id}"
@@ -65,7 +65,10 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 			"provider_arns": {
 				Type:     schema.TypeSet,
 				Optional: true, // provider_arns is required for authorizer COGNITO_USER_POOLS.
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArnServiceResource("cognito-idp", "userpool/"),
+				},
 			},
 		},
 	}
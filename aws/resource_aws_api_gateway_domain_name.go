@@ -62,6 +62,7 @@ func resourceAwsApiGatewayDomainName() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"certificate_body", "certificate_chain", "certificate_name", "certificate_private_key"},
+				ValidateFunc:  validateAcmCertificateArn,
 			},
 
 			"cloudfront_domain_name": {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 		Read:   resourceAwsApiGatewayMethodSettingsRead,
 		Update: resourceAwsApiGatewayMethodSettingsUpdate,
 		Delete: resourceAwsApiGatewayMethodSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayMethodSettingsImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -33,6 +37,8 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			// The nested settings have the API Gateway defaults, so that removing
+			// one from the configuration resets it.
 			"settings": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -42,42 +48,52 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 						"metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Default:  false,
 						},
 						"logging_level": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Default:  "OFF",
 						},
 						"data_trace_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Default:  false,
 						},
 						"throttling_burst_limit": {
 							Type:     schema.TypeInt,
 							Optional: true,
+							Default:  -1,
 						},
 						"throttling_rate_limit": {
 							Type:     schema.TypeFloat,
 							Optional: true,
+							Default:  -1.0,
 						},
 						"caching_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Default:  false,
 						},
 						"cache_ttl_in_seconds": {
 							Type:     schema.TypeInt,
 							Optional: true,
+							Default:  300,
 						},
 						"cache_data_encrypted": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Default:  false,
 						},
 						"require_authorization_for_cache_control": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Default:  true,
 						},
 						"unauthorized_cache_control_header_strategy": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Default:  apigateway.UnauthorizedCacheControlHeaderStrategySucceedWithResponseHeader,
 						},
 					},
 				},
@@ -113,16 +129,9 @@ func resourceAwsApiGatewayMethodSettingsRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	d.Set("settings.0.metrics_enabled", settings.MetricsEnabled)
-	d.Set("settings.0.logging_level", settings.LoggingLevel)
-	d.Set("settings.0.data_trace_enabled", settings.DataTraceEnabled)
-	d.Set("settings.0.throttling_burst_limit", settings.ThrottlingBurstLimit)
-	d.Set("settings.0.throttling_rate_limit", settings.ThrottlingRateLimit)
-	d.Set("settings.0.caching_enabled", settings.CachingEnabled)
-	d.Set("settings.0.cache_ttl_in_seconds", settings.CacheTtlInSeconds)
-	d.Set("settings.0.cache_data_encrypted", settings.CacheDataEncrypted)
-	d.Set("settings.0.require_authorization_for_cache_control", settings.RequireAuthorizationForCacheControl)
-	d.Set("settings.0.unauthorized_cache_control_header_strategy", settings.UnauthorizedCacheControlHeaderStrategy)
+	if err := d.Set("settings", flattenAwsApiGatewayMethodSettings(settings)); err != nil {
+		return fmt.Errorf("error setting settings: %s", err)
+	}
 
 	return nil
 }
@@ -188,7 +197,7 @@ func resourceAwsApiGatewayMethodSettingsUpdate(d *schema.ResourceData, meta inte
 		ops = append(ops, &apigateway.PatchOperation{
 			Op:    aws.String("replace"),
 			Path:  aws.String(prefix + "caching/dataEncrypted"),
-			Value: aws.String(fmt.Sprintf("%d", d.Get("settings.0.cache_data_encrypted").(int))),
+			Value: aws.String(fmt.Sprintf("%t", d.Get("settings.0.cache_data_encrypted").(bool))),
 		})
 	}
 	if d.HasChange("settings.0.require_authorization_for_cache_control") {
@@ -246,3 +255,42 @@ func resourceAwsApiGatewayMethodSettingsDelete(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+func resourceAwsApiGatewayMethodSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME/METHOD-PATH", d.Id())
+	}
+
+	restApiId := idParts[0]
+	stageName := idParts[1]
+	methodPath := idParts[2]
+
+	d.Set("rest_api_id", restApiId)
+	d.Set("stage_name", stageName)
+	d.Set("method_path", methodPath)
+	d.SetId(restApiId + "-" + stageName + "-" + methodPath)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenAwsApiGatewayMethodSettings(settings *apigateway.MethodSetting) []interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"metrics_enabled":                            aws.BoolValue(settings.MetricsEnabled),
+			"logging_level":                              aws.StringValue(settings.LoggingLevel),
+			"data_trace_enabled":                         aws.BoolValue(settings.DataTraceEnabled),
+			"throttling_burst_limit":                     int(aws.Int64Value(settings.ThrottlingBurstLimit)),
+			"throttling_rate_limit":                      aws.Float64Value(settings.ThrottlingRateLimit),
+			"caching_enabled":                            aws.BoolValue(settings.CachingEnabled),
+			"cache_ttl_in_seconds":                       int(aws.Int64Value(settings.CacheTtlInSeconds)),
+			"cache_data_encrypted":                       aws.BoolValue(settings.CacheDataEncrypted),
+			"require_authorization_for_cache_control":    aws.BoolValue(settings.RequireAuthorizationForCacheControl),
+			"unauthorized_cache_control_header_strategy": aws.StringValue(settings.UnauthorizedCacheControlHeaderStrategy),
+		},
+	}
+}
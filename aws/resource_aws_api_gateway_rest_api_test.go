@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -34,6 +35,7 @@ func testSweepAPIGatewayRestApis(region string) error {
 		"tf-acc-",
 	}
 
+	var errors error
 	err = conn.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
 		for _, item := range page.Items {
 			skip := true
@@ -48,6 +50,11 @@ func testSweepAPIGatewayRestApis(region string) error {
 				continue
 			}
 
+			if testSweepDryRun() {
+				log.Printf("[INFO] Would delete API Gateway REST API: %s", *item.Name)
+				continue
+			}
+
 			input := &apigateway.DeleteRestApiInput{
 				RestApiId: item.Id,
 			}
@@ -64,8 +71,7 @@ func testSweepAPIGatewayRestApis(region string) error {
 				return nil
 			})
 			if err != nil {
-				log.Printf("[ERROR] Failed to delete API Gateway REST API %s: %s", *item.Name, err)
-				continue
+				errors = multierror.Append(errors, fmt.Errorf("Failed to delete API Gateway REST API %s: %s", *item.Name, err))
 			}
 		}
 		return !lastPage
@@ -74,7 +80,7 @@ func testSweepAPIGatewayRestApis(region string) error {
 		return fmt.Errorf("Error retrieving API Gateway REST APIs: %s", err)
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSAPIGatewayRestApi_basic(t *testing.T) {
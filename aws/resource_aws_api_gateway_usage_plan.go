@@ -340,125 +340,145 @@ func resourceAwsApiGatewayUsagePlanUpdate(d *schema.ResourceData, meta interface
 
 	if d.HasChange("throttle_settings") {
 		o, n := d.GetChange("throttle_settings")
+		operations = append(operations, resourceAwsApiGatewayUsagePlanThrottlePatchOperations(o.(*schema.Set), n.(*schema.Set))...)
+	}
 
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-		diff := ns.Difference(os).List()
-
-		// Handle Removal
-		if len(diff) == 0 {
-			operations = append(operations, &apigateway.PatchOperation{
-				Op:   aws.String("remove"),
-				Path: aws.String("/throttle"),
-			})
+	if d.HasChange("quota_settings") {
+		o, n := d.GetChange("quota_settings")
+		ops, err := resourceAwsApiGatewayUsagePlanQuotaPatchOperations(o.(*schema.Set), n.(*schema.Set))
+		if err != nil {
+			return err
 		}
+		operations = append(operations, ops...)
+	}
 
-		if len(diff) > 0 {
-			d := diff[0].(map[string]interface{})
+	params := &apigateway.UpdateUsagePlanInput{
+		UsagePlanId:     aws.String(d.Id()),
+		PatchOperations: operations,
+	}
 
-			// Handle Replaces
-			if o != nil && n != nil {
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("replace"),
-					Path:  aws.String("/throttle/rateLimit"),
-					Value: aws.String(strconv.FormatFloat(d["rate_limit"].(float64), 'f', -1, 64)),
-				})
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("replace"),
-					Path:  aws.String("/throttle/burstLimit"),
-					Value: aws.String(strconv.Itoa(d["burst_limit"].(int))),
-				})
-			}
+	_, err := conn.UpdateUsagePlan(params)
+	if err != nil {
+		return fmt.Errorf("Error updating API Gateway Usage Plan: %s", err)
+	}
 
-			// Handle Additions
-			if o == nil && n != nil {
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("add"),
-					Path:  aws.String("/throttle/rateLimit"),
-					Value: aws.String(strconv.FormatFloat(d["rate_limit"].(float64), 'f', -1, 64)),
-				})
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("add"),
-					Path:  aws.String("/throttle/burstLimit"),
-					Value: aws.String(strconv.Itoa(d["burst_limit"].(int))),
-				})
-			}
-		}
+	return resourceAwsApiGatewayUsagePlanRead(d, meta)
+}
+
+// resourceAwsApiGatewayUsagePlanThrottlePatchOperations returns the patch
+// operations which change the throttle settings from os to ns. Settings are
+// added when the plan has none yet, and replaced otherwise.
+func resourceAwsApiGatewayUsagePlanThrottlePatchOperations(os, ns *schema.Set) []*apigateway.PatchOperation {
+	operations := make([]*apigateway.PatchOperation, 0)
+
+	diff := ns.Difference(os).List()
+
+	// Handle Removal
+	if len(diff) == 0 {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:   aws.String("remove"),
+			Path: aws.String("/throttle"),
+		})
 	}
 
-	if d.HasChange("quota_settings") {
-		o, n := d.GetChange("quota_settings")
+	if len(diff) > 0 {
+		d := diff[0].(map[string]interface{})
 
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-		diff := ns.Difference(os).List()
+		// Handle Replaces
+		if os.Len() > 0 {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/throttle/rateLimit"),
+				Value: aws.String(strconv.FormatFloat(d["rate_limit"].(float64), 'f', -1, 64)),
+			})
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/throttle/burstLimit"),
+				Value: aws.String(strconv.Itoa(d["burst_limit"].(int))),
+			})
+		}
 
-		// Handle Removal
-		if len(diff) == 0 {
+		// Handle Additions
+		if os.Len() == 0 {
 			operations = append(operations, &apigateway.PatchOperation{
-				Op:   aws.String("remove"),
-				Path: aws.String("/quota"),
+				Op:    aws.String("add"),
+				Path:  aws.String("/throttle/rateLimit"),
+				Value: aws.String(strconv.FormatFloat(d["rate_limit"].(float64), 'f', -1, 64)),
+			})
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("add"),
+				Path:  aws.String("/throttle/burstLimit"),
+				Value: aws.String(strconv.Itoa(d["burst_limit"].(int))),
 			})
 		}
+	}
 
-		if len(diff) > 0 {
-			d := diff[0].(map[string]interface{})
+	return operations
+}
 
-			if errors := validateApiGatewayUsagePlanQuotaSettings(d); len(errors) > 0 {
-				return fmt.Errorf("Error validating the quota settings: %v", errors)
-			}
+// resourceAwsApiGatewayUsagePlanQuotaPatchOperations returns the patch
+// operations which change the quota settings from os to ns. Settings are
+// added when the plan has none yet, and replaced otherwise.
+func resourceAwsApiGatewayUsagePlanQuotaPatchOperations(os, ns *schema.Set) ([]*apigateway.PatchOperation, error) {
+	operations := make([]*apigateway.PatchOperation, 0)
 
-			// Handle Replaces
-			if o != nil && n != nil {
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("replace"),
-					Path:  aws.String("/quota/limit"),
-					Value: aws.String(strconv.Itoa(d["limit"].(int))),
-				})
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("replace"),
-					Path:  aws.String("/quota/offset"),
-					Value: aws.String(strconv.Itoa(d["offset"].(int))),
-				})
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("replace"),
-					Path:  aws.String("/quota/period"),
-					Value: aws.String(d["period"].(string)),
-				})
-			}
+	diff := ns.Difference(os).List()
 
-			// Handle Additions
-			if o == nil && n != nil {
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("add"),
-					Path:  aws.String("/quota/limit"),
-					Value: aws.String(strconv.Itoa(d["limit"].(int))),
-				})
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("add"),
-					Path:  aws.String("/quota/offset"),
-					Value: aws.String(strconv.Itoa(d["offset"].(int))),
-				})
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String("add"),
-					Path:  aws.String("/quota/period"),
-					Value: aws.String(d["period"].(string)),
-				})
-			}
-		}
+	// Handle Removal
+	if len(diff) == 0 {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:   aws.String("remove"),
+			Path: aws.String("/quota"),
+		})
 	}
 
-	params := &apigateway.UpdateUsagePlanInput{
-		UsagePlanId:     aws.String(d.Id()),
-		PatchOperations: operations,
-	}
+	if len(diff) > 0 {
+		d := diff[0].(map[string]interface{})
 
-	_, err := conn.UpdateUsagePlan(params)
-	if err != nil {
-		return fmt.Errorf("Error updating API Gateway Usage Plan: %s", err)
+		if errors := validateApiGatewayUsagePlanQuotaSettings(d); len(errors) > 0 {
+			return nil, fmt.Errorf("Error validating the quota settings: %v", errors)
+		}
+
+		// Handle Replaces
+		if os.Len() > 0 {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/quota/limit"),
+				Value: aws.String(strconv.Itoa(d["limit"].(int))),
+			})
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/quota/offset"),
+				Value: aws.String(strconv.Itoa(d["offset"].(int))),
+			})
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("replace"),
+				Path:  aws.String("/quota/period"),
+				Value: aws.String(d["period"].(string)),
+			})
+		}
+
+		// Handle Additions
+		if os.Len() == 0 {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("add"),
+				Path:  aws.String("/quota/limit"),
+				Value: aws.String(strconv.Itoa(d["limit"].(int))),
+			})
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("add"),
+				Path:  aws.String("/quota/offset"),
+				Value: aws.String(strconv.Itoa(d["offset"].(int))),
+			})
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String("add"),
+				Path:  aws.String("/quota/period"),
+				Value: aws.String(d["period"].(string)),
+			})
+		}
 	}
 
-	return resourceAwsApiGatewayUsagePlanRead(d, meta)
+	return operations, nil
 }
 
 func resourceAwsApiGatewayUsagePlanDelete(d *schema.ResourceData, meta interface{}) error {
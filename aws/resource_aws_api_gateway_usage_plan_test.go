@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -9,9 +10,78 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceAwsApiGatewayUsagePlanThrottlePatchOperations(t *testing.T) {
+	elem := resourceAwsApiGatewayUsagePlan().Schema["throttle_settings"].Elem.(*schema.Resource)
+	newSet := func(items ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashResource(elem), items)
+	}
+	before := map[string]interface{}{"burst_limit": 2, "rate_limit": 5.0}
+	after := map[string]interface{}{"burst_limit": 3, "rate_limit": 6.5}
+
+	cases := []struct {
+		Name     string
+		Old, New *schema.Set
+		Expected []string
+	}{
+		{"add", newSet(), newSet(after), []string{"add /throttle/rateLimit 6.5", "add /throttle/burstLimit 3"}},
+		{"replace", newSet(before), newSet(after), []string{"replace /throttle/rateLimit 6.5", "replace /throttle/burstLimit 3"}},
+		{"remove", newSet(before), newSet(), []string{"remove /throttle "}},
+	}
+
+	for _, tc := range cases {
+		ops := resourceAwsApiGatewayUsagePlanThrottlePatchOperations(tc.Old, tc.New)
+		if got := testApiGatewayPatchOperationStrings(ops); !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%s: expected %q, got %q", tc.Name, tc.Expected, got)
+		}
+	}
+}
+
+func TestResourceAwsApiGatewayUsagePlanQuotaPatchOperations(t *testing.T) {
+	elem := resourceAwsApiGatewayUsagePlan().Schema["quota_settings"].Elem.(*schema.Resource)
+	newSet := func(items ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashResource(elem), items)
+	}
+	before := map[string]interface{}{"limit": 100, "offset": 6, "period": "WEEK"}
+	after := map[string]interface{}{"limit": 200, "offset": 20, "period": "MONTH"}
+
+	cases := []struct {
+		Name     string
+		Old, New *schema.Set
+		Expected []string
+	}{
+		{"add", newSet(), newSet(after), []string{"add /quota/limit 200", "add /quota/offset 20", "add /quota/period MONTH"}},
+		{"replace", newSet(before), newSet(after), []string{"replace /quota/limit 200", "replace /quota/offset 20", "replace /quota/period MONTH"}},
+		{"remove", newSet(before), newSet(), []string{"remove /quota "}},
+	}
+
+	for _, tc := range cases {
+		ops, err := resourceAwsApiGatewayUsagePlanQuotaPatchOperations(tc.Old, tc.New)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+		if got := testApiGatewayPatchOperationStrings(ops); !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%s: expected %q, got %q", tc.Name, tc.Expected, got)
+		}
+	}
+
+	invalid := newSet(map[string]interface{}{"limit": 100, "offset": 1, "period": "DAY"})
+	if _, err := resourceAwsApiGatewayUsagePlanQuotaPatchOperations(newSet(), invalid); err == nil {
+		t.Fatal("expected an error for an offset with a DAY period")
+	}
+}
+
+func testApiGatewayPatchOperationStrings(ops []*apigateway.PatchOperation) []string {
+	out := make([]string, 0, len(ops))
+	for _, op := range ops {
+		out = append(out, fmt.Sprintf("%s %s %s", aws.StringValue(op.Op), aws.StringValue(op.Path), aws.StringValue(op.Value)))
+	}
+	return out
+}
+
 func TestAccAWSAPIGatewayUsagePlan_basic(t *testing.T) {
 	var conf apigateway.UsagePlan
 	name := acctest.RandString(10)
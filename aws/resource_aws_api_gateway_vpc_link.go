@@ -32,7 +32,10 @@ func resourceAwsApiGatewayVpcLink() *schema.Resource {
 				MaxItems: 1,
 				Required: true,
 				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArnServiceResource("elasticloadbalancing", "loadbalancer/net/"),
+				},
 			},
 		},
 	}
@@ -99,16 +99,18 @@ func resourceAwsAppsyncDatasource() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"function_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 					},
 				},
 				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config"},
 			},
 			"service_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"arn": {
 				Type:     schema.TypeString,
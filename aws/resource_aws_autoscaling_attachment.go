@@ -31,9 +31,10 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 			},
 
 			"alb_target_group_arn": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: validateLbTargetGroupArn,
 			},
 		},
 	}
@@ -187,8 +187,11 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateLbTargetGroupArn,
+				},
+				Set: schema.HashString,
 			},
 
 			"arn": {
@@ -223,12 +226,14 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 							Optional: true,
 						},
 						"notification_target_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArnService("sns", "sqs"),
 						},
 						"role_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -244,9 +249,10 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			},
 
 			"service_linked_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 		},
 	}
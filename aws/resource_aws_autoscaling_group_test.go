@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -34,31 +35,37 @@ func testSweepAutoscalingGroups(region string) error {
 	}
 	conn := client.(*AWSClient).autoscalingconn
 
-	resp, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{})
+	var names []string
+	err = conn.DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{}, func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		for _, asg := range page.AutoScalingGroups {
+			for _, testName := range []string{"foobar", "terraform-", "tf-test", "tf-asg-"} {
+				if strings.HasPrefix(*asg.AutoScalingGroupName, testName) {
+					names = append(names, *asg.AutoScalingGroupName)
+					break
+				}
+			}
+		}
+		return !lastPage
+	})
 	if err != nil {
 		return fmt.Errorf("Error retrieving AutoScaling Groups in Sweeper: %s", err)
 	}
 
-	if len(resp.AutoScalingGroups) == 0 {
+	if len(names) == 0 {
 		log.Print("[DEBUG] No aws autoscaling groups to sweep")
 		return nil
 	}
 
-	for _, asg := range resp.AutoScalingGroups {
-		var testOptGroup bool
-		for _, testName := range []string{"foobar", "terraform-", "tf-test", "tf-asg-"} {
-			if strings.HasPrefix(*asg.AutoScalingGroupName, testName) {
-				testOptGroup = true
-				break
-			}
-		}
-
-		if !testOptGroup {
+	var errors error
+	for _, name := range names {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete AutoScaling Group %s", name)
 			continue
 		}
 
+		log.Printf("[INFO] Deleting AutoScaling Group %s", name)
 		deleteopts := autoscaling.DeleteAutoScalingGroupInput{
-			AutoScalingGroupName: asg.AutoScalingGroupName,
+			AutoScalingGroupName: aws.String(name),
 			ForceDelete:          aws.Bool(true),
 		}
 
@@ -80,11 +87,11 @@ func testSweepAutoscalingGroups(region string) error {
 			return nil
 		})
 		if err != nil {
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting AutoScaling Group %s: %s", name, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSAutoScalingGroup_basic(t *testing.T) {
@@ -19,9 +19,10 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"topic_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSnsTopicArn,
 			},
 
 			"group_names": &schema.Schema{
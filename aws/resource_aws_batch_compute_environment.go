@@ -56,7 +56,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamInstanceProfileArn,
 						},
 						"instance_type": {
 							Type:     schema.TypeSet,
@@ -82,7 +82,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 						"subnets": {
 							Type:     schema.TypeSet,
@@ -103,7 +103,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 			"service_role": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"state": {
 				Type:         schema.TypeString,
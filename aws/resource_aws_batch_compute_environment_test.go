@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -36,39 +37,57 @@ func testSweepBatchComputeEnvironments(region string) error {
 		"tf_acc",
 	}
 
-	out, err := conn.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{})
-	if err != nil {
-		return fmt.Errorf("Error retrieving Batch Compute Environments: %s", err)
-	}
-	for _, computeEnvironment := range out.ComputeEnvironments {
-		name := computeEnvironment.ComputeEnvironmentName
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(*name, prefix) {
-				skip = false
-				break
+	var names []string
+	input := &batch.DescribeComputeEnvironmentsInput{}
+	for {
+		out, err := conn.DescribeComputeEnvironments(input)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Batch Compute Environments: %s", err)
+		}
+		for _, computeEnvironment := range out.ComputeEnvironments {
+			name := computeEnvironment.ComputeEnvironmentName
+			skip := true
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(*name, prefix) {
+					skip = false
+					break
+				}
+			}
+			if skip {
+				log.Printf("[INFO] Skipping Batch Compute Environment: %s", *name)
+				continue
 			}
+			names = append(names, *name)
 		}
-		if skip {
-			log.Printf("[INFO] Skipping Batch Compute Environment: %s", *name)
+
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	var errors error
+	for _, name := range names {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete Batch Compute Environment: %s", name)
 			continue
 		}
 
-		log.Printf("[INFO] Disabling Batch Compute Environment: %s", *name)
-		err := disableBatchComputeEnvironment(*name, 20*time.Minute, conn)
+		log.Printf("[INFO] Disabling Batch Compute Environment: %s", name)
+		err := disableBatchComputeEnvironment(name, 20*time.Minute, conn)
 		if err != nil {
-			log.Printf("[ERROR] Failed to disable Batch Compute Environment %s: %s", *name, err)
+			errors = multierror.Append(errors, fmt.Errorf("Failed to disable Batch Compute Environment %s: %s", name, err))
 			continue
 		}
 
-		log.Printf("[INFO] Deleting Batch Compute Environment: %s", *name)
-		err = deleteBatchComputeEnvironment(*name, 20*time.Minute, conn)
+		log.Printf("[INFO] Deleting Batch Compute Environment: %s", name)
+		err = deleteBatchComputeEnvironment(name, 20*time.Minute, conn)
 		if err != nil {
-			log.Printf("[ERROR] Failed to delete Batch Compute Environment %s: %s", *name, err)
+			errors = multierror.Append(errors, fmt.Errorf("Failed to delete Batch Compute Environment %s: %s", name, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSBatchComputeEnvironment_createEc2(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -32,39 +33,57 @@ func testSweepBatchJobQueues(region string) error {
 		"tf_acc",
 	}
 
-	out, err := conn.DescribeJobQueues(&batch.DescribeJobQueuesInput{})
-	if err != nil {
-		return fmt.Errorf("Error retrieving Batch Job Queues: %s", err)
-	}
-	for _, jobQueue := range out.JobQueues {
-		name := jobQueue.JobQueueName
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(*name, prefix) {
-				skip = false
-				break
+	var names []string
+	input := &batch.DescribeJobQueuesInput{}
+	for {
+		out, err := conn.DescribeJobQueues(input)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Batch Job Queues: %s", err)
+		}
+		for _, jobQueue := range out.JobQueues {
+			name := jobQueue.JobQueueName
+			skip := true
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(*name, prefix) {
+					skip = false
+					break
+				}
+			}
+			if skip {
+				log.Printf("[INFO] Skipping Batch Job Queue: %s", *name)
+				continue
 			}
+			names = append(names, *name)
 		}
-		if skip {
-			log.Printf("[INFO] Skipping Batch Job Queue: %s", *name)
+
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	var errors error
+	for _, name := range names {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete Batch Job Queue: %s", name)
 			continue
 		}
 
-		log.Printf("[INFO] Disabling Batch Job Queue: %s", *name)
-		err := disableBatchJobQueue(*name, 10*time.Minute, conn)
+		log.Printf("[INFO] Disabling Batch Job Queue: %s", name)
+		err := disableBatchJobQueue(name, 10*time.Minute, conn)
 		if err != nil {
-			log.Printf("[ERROR] Failed to disable Batch Job Queue %s: %s", *name, err)
+			errors = multierror.Append(errors, fmt.Errorf("Failed to disable Batch Job Queue %s: %s", name, err))
 			continue
 		}
 
-		log.Printf("[INFO] Deleting Batch Job Queue: %s", *name)
-		err = deleteBatchJobQueue(*name, 10*time.Minute, conn)
+		log.Printf("[INFO] Deleting Batch Job Queue: %s", name)
+		err = deleteBatchJobQueue(name, 10*time.Minute, conn)
 		if err != nil {
-			log.Printf("[ERROR] Failed to delete Batch Job Queue %s: %s", *name, err)
+			errors = multierror.Append(errors, fmt.Errorf("Failed to delete Batch Job Queue %s: %s", name, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSBatchJobQueue_basic(t *testing.T) {
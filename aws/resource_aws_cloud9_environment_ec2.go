@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -46,7 +47,7 @@ func resourceAwsCloud9EnvironmentEc2() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnService("iam", "sts"),
 			},
 			"subnet_id": {
 				Type:     schema.TypeString,
@@ -61,6 +62,16 @@ func resourceAwsCloud9EnvironmentEc2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -171,6 +182,24 @@ func resourceAwsCloud9EnvironmentEc2Read(d *schema.ResourceData, meta interface{
 
 	log.Printf("[DEBUG] Received Cloud9 Environment EC2: %s", env)
 
+	instance, err := cloud9EnvironmentEc2Instance(meta.(*AWSClient).ec2conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Cloud9 Environment EC2 (%s) instance: %s", d.Id(), err)
+	}
+
+	instanceId := ""
+	var securityGroupIds []string
+	if instance != nil {
+		instanceId = aws.StringValue(instance.InstanceId)
+		for _, sg := range instance.SecurityGroups {
+			securityGroupIds = append(securityGroupIds, aws.StringValue(sg.GroupId))
+		}
+	}
+	d.Set("instance_id", instanceId)
+	if err := d.Set("security_group_ids", securityGroupIds); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %s", err)
+	}
+
 	return nil
 }
 
@@ -229,3 +258,28 @@ func resourceAwsCloud9EnvironmentEc2Delete(d *schema.ResourceData, meta interfac
 
 	return err
 }
+
+// cloud9EnvironmentEc2Instance returns the EC2 instance backing the given
+// Cloud9 environment, or nil if it has none (e.g. it has been terminated).
+// Cloud9 tags the instances it launches with the environment ID.
+func cloud9EnvironmentEc2Instance(conn *ec2.EC2, environmentId string) (*ec2.Instance, error) {
+	out, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"tag:aws:cloud9:environment": environmentId,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range out.Reservations {
+		for _, instance := range r.Instances {
+			if instance.State != nil && aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
+				continue
+			}
+			return instance, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloud9EnvironmentMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloud9EnvironmentMembershipCreate,
+		Read:   resourceAwsCloud9EnvironmentMembershipRead,
+		Update: resourceAwsCloud9EnvironmentMembershipUpdate,
+		Delete: resourceAwsCloud9EnvironmentMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnService("iam", "sts"),
+			},
+			"permissions": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					cloud9.MemberPermissionsReadOnly,
+					cloud9.MemberPermissionsReadWrite,
+				}, false),
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloud9EnvironmentMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	envId := d.Get("environment_id").(string)
+	userArn := d.Get("user_arn").(string)
+
+	input := &cloud9.CreateEnvironmentMembershipInput{
+		EnvironmentId: aws.String(envId),
+		Permissions:   aws.String(d.Get("permissions").(string)),
+		UserArn:       aws.String(userArn),
+	}
+
+	log.Printf("[DEBUG] Creating Cloud9 Environment Membership: %s", input)
+	_, err := conn.CreateEnvironmentMembership(input)
+	if err != nil {
+		return fmt.Errorf("error creating Cloud9 Environment Membership: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s#%s", envId, userArn))
+
+	return resourceAwsCloud9EnvironmentMembershipRead(d, meta)
+}
+
+func resourceAwsCloud9EnvironmentMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	envId, userArn, err := resourceAwsCloud9EnvironmentMembershipParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := cloud9EnvironmentMember(conn, envId, userArn)
+	if err != nil {
+		if isAWSErr(err, cloud9.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] Cloud9 Environment Membership (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Cloud9 Environment Membership (%s): %s", d.Id(), err)
+	}
+	if member == nil {
+		log.Printf("[WARN] Cloud9 Environment Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("environment_id", member.EnvironmentId)
+	d.Set("user_arn", member.UserArn)
+	d.Set("permissions", member.Permissions)
+	d.Set("user_id", member.UserId)
+
+	return nil
+}
+
+func resourceAwsCloud9EnvironmentMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	input := &cloud9.UpdateEnvironmentMembershipInput{
+		EnvironmentId: aws.String(d.Get("environment_id").(string)),
+		Permissions:   aws.String(d.Get("permissions").(string)),
+		UserArn:       aws.String(d.Get("user_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Cloud9 Environment Membership: %s", input)
+	_, err := conn.UpdateEnvironmentMembership(input)
+	if err != nil {
+		return fmt.Errorf("error updating Cloud9 Environment Membership (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloud9EnvironmentMembershipRead(d, meta)
+}
+
+func resourceAwsCloud9EnvironmentMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloud9conn
+
+	input := &cloud9.DeleteEnvironmentMembershipInput{
+		EnvironmentId: aws.String(d.Get("environment_id").(string)),
+		UserArn:       aws.String(d.Get("user_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Cloud9 Environment Membership: %s", input)
+	_, err := conn.DeleteEnvironmentMembership(input)
+	if err != nil {
+		if isAWSErr(err, cloud9.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Cloud9 Environment Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloud9EnvironmentMembershipParseId(id string) (string, string, error) {
+	parts := strings.SplitN(id, "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected ENVIRONMENT-ID#USER-ARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// cloud9EnvironmentMember returns the membership of the given user in the
+// given Cloud9 environment, or nil if the user is not a member.
+func cloud9EnvironmentMember(conn *cloud9.Cloud9, environmentId, userArn string) (*cloud9.EnvironmentMember, error) {
+	var member *cloud9.EnvironmentMember
+
+	input := &cloud9.DescribeEnvironmentMembershipsInput{
+		EnvironmentId: aws.String(environmentId),
+		UserArn:       aws.String(userArn),
+	}
+	err := conn.DescribeEnvironmentMembershipsPages(input, func(page *cloud9.DescribeEnvironmentMembershipsOutput, lastPage bool) bool {
+		for _, m := range page.Memberships {
+			if aws.StringValue(m.EnvironmentId) == environmentId && aws.StringValue(m.UserArn) == userArn {
+				member = m
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return member, err
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsCloud9EnvironmentMembershipParseId(t *testing.T) {
+	envId, userArn, err := resourceAwsCloud9EnvironmentMembershipParseId("abc123#arn:aws:iam::123456789012:user/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if envId != "abc123" {
+		t.Fatalf("expected environment ID %q, got %q", "abc123", envId)
+	}
+	if userArn != "arn:aws:iam::123456789012:user/test" {
+		t.Fatalf("expected user ARN %q, got %q", "arn:aws:iam::123456789012:user/test", userArn)
+	}
+
+	invalidIds := []string{
+		"",
+		"abc123",
+		"abc123#",
+		"#arn:aws:iam::123456789012:user/test",
+	}
+
+	for _, id := range invalidIds {
+		if _, _, err := resourceAwsCloud9EnvironmentMembershipParseId(id); err == nil {
+			t.Fatalf("%q should not be a valid Cloud9 environment membership ID", id)
+		}
+	}
+}
+
+func TestAccAWSCloud9EnvironmentMembership_basic(t *testing.T) {
+	var conf cloud9.EnvironmentMember
+
+	rString := acctest.RandString(8)
+	envName := fmt.Sprintf("tf_acc_env_member_%s", rString)
+	userName := fmt.Sprintf("tf_acc_cloud9_member_%s", rString)
+
+	resourceName := "aws_cloud9_environment_membership.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloud9EnvironmentMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloud9EnvironmentMembershipConfig(envName, userName, "read-only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentMembershipExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions", "read-only"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_arn", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "aws_cloud9_environment_ec2.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+				),
+			},
+			{
+				Config: testAccAWSCloud9EnvironmentMembershipConfig(envName, userName, "read-write"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloud9EnvironmentMembershipExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "permissions", "read-write"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloud9EnvironmentMembershipExists(n string, res *cloud9.EnvironmentMember) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cloud9 Environment Membership ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+
+		envId, userArn, err := resourceAwsCloud9EnvironmentMembershipParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := cloud9EnvironmentMember(conn, envId, userArn)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return fmt.Errorf("Cloud9 Environment Membership (%q) not found", rs.Primary.ID)
+		}
+
+		*res = *member
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloud9EnvironmentMembershipDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloud9_environment_membership" {
+			continue
+		}
+
+		envId, userArn, err := resourceAwsCloud9EnvironmentMembershipParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := cloud9EnvironmentMember(conn, envId, userArn)
+		if err != nil {
+			if isAWSErr(err, cloud9.ErrCodeNotFoundException, "") {
+				continue
+			}
+			// :'-(
+			if isAWSErr(err, "AccessDeniedException", "is not authorized to access this resource") {
+				continue
+			}
+			return err
+		}
+		if member != nil {
+			return fmt.Errorf("Cloud9 Environment Membership %q still exists.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAWSCloud9EnvironmentMembershipConfig(envName, userName, permissions string) string {
+	return fmt.Sprintf(`
+resource "aws_cloud9_environment_ec2" "test" {
+  instance_type = "t2.micro"
+  name          = "%s"
+}
+
+resource "aws_iam_user" "test" {
+  name = "%s"
+}
+
+resource "aws_cloud9_environment_membership" "test" {
+  environment_id = "${aws_cloud9_environment_ec2.test.id}"
+  user_arn       = "${aws_iam_user.test.arn}"
+  permissions    = "%s"
+}
+`, envName, userName, permissions)
+}
@@ -66,8 +66,11 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 			"notification_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSnsTopicArn,
+				},
+				Set: schema.HashString,
 			},
 			"on_failure": {
 				Type:     schema.TypeString,
@@ -107,8 +110,9 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Optional: true,
 			},
 			"iam_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 		},
 	}
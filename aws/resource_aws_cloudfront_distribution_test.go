@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -48,6 +49,7 @@ func testSweepCloudFrontDistributions(region string) error {
 		return nil
 	}
 
+	var errors error
 	for _, distributionSummary := range distributionSummaries {
 		distributionID := *distributionSummary.Id
 
@@ -56,23 +58,30 @@ func testSweepCloudFrontDistributions(region string) error {
 			continue
 		}
 
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete CloudFront Distribution: %s", distributionID)
+			continue
+		}
+
 		output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
 			Id: aws.String(distributionID),
 		})
 		if err != nil {
-			return fmt.Errorf("Error reading CloudFront Distribution %s: %s", distributionID, err)
+			errors = multierror.Append(errors, fmt.Errorf("Error reading CloudFront Distribution %s: %s", distributionID, err))
+			continue
 		}
 
+		log.Printf("[INFO] Deleting CloudFront Distribution: %s", distributionID)
 		_, err = conn.DeleteDistribution(&cloudfront.DeleteDistributionInput{
 			Id:      aws.String(distributionID),
 			IfMatch: output.ETag,
 		})
 		if err != nil {
-			return fmt.Errorf("Error deleting CloudFront Distribution %s: %s", distributionID, err)
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting CloudFront Distribution %s: %s", distributionID, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 // TestAccAWSCloudFrontDistribution_S3Origin runs an
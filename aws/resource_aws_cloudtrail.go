@@ -42,12 +42,14 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Optional: true,
 			},
 			"cloud_watch_logs_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"cloud_watch_logs_group_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCloudWatchLogGroupArn,
 			},
 			"include_global_service_events": {
 				Type:     schema.TypeBool,
@@ -71,7 +73,7 @@ func resourceAwsCloudTrail() *schema.Resource {
 			"kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 			"event_selector": {
 				Type:     schema.TypeList,
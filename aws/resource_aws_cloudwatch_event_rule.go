@@ -3,14 +3,12 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 )
@@ -54,7 +52,7 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateMaxLength(1600),
+				ValidateFunc: validateAll(validateIamRoleArn, validateMaxLength(1600)),
 			},
 			"is_enabled": {
 				Type:     schema.TypeBool,
@@ -69,6 +67,12 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 	}
 }
 
+// cloudWatchEventRuleIamPropagationErrors are returned by PutRule until the
+// rule's role has propagated.
+var cloudWatchEventRuleIamPropagationErrors = []awsErrMatcher{
+	{"ValidationException", "cannot be assumed by principal"},
+}
+
 func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
@@ -79,25 +83,13 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Creating CloudWatch Event Rule: %s", input)
 
 	// IAM Roles take some time to propagate
-	var out *events.PutRuleOutput
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		out, err = conn.PutRule(input)
-		pattern := regexp.MustCompile("cannot be assumed by principal '[a-z]+\\.amazonaws\\.com'\\.$")
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "ValidationException" && pattern.MatchString(awsErr.Message()) {
-					log.Printf("[DEBUG] Retrying creation of CloudWatch Event Rule %q", *input.Name)
-					return resource.RetryableError(err)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	raw, err := retryOnIamPropagation(30*time.Second, cloudWatchEventRuleIamPropagationErrors, func() (interface{}, error) {
+		return conn.PutRule(input)
 	})
 	if err != nil {
 		return errwrap.Wrapf("Creating CloudWatch Event Rule failed: {{err}}", err)
 	}
+	out := raw.(*events.PutRuleOutput)
 
 	d.Set("arn", out.RuleArn)
 	d.SetId(d.Get("name").(string))
@@ -171,19 +163,8 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Updating CloudWatch Event Rule: %s", input)
 
 	// IAM Roles take some time to propagate
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		_, err := conn.PutRule(input)
-		pattern := regexp.MustCompile("cannot be assumed by principal '[a-z]+\\.amazonaws\\.com'\\.$")
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "ValidationException" && pattern.MatchString(awsErr.Message()) {
-					log.Printf("[DEBUG] Retrying update of CloudWatch Event Rule %q", *input.Name)
-					return resource.RetryableError(err)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err = retryOnIamPropagation(30*time.Second, cloudWatchEventRuleIamPropagationErrors, func() (interface{}, error) {
+		return conn.PutRule(input)
 	})
 	if err != nil {
 		return errwrap.Wrapf("Updating CloudWatch Event Rule failed: {{err}}", err)
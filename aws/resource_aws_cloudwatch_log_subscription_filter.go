@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
@@ -21,6 +22,9 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 		Read:   resourceAwsCloudwatchLogSubscriptionFilterRead,
 		Update: resourceAwsCloudwatchLogSubscriptionFilterUpdate,
 		Delete: resourceAwsCloudwatchLogSubscriptionFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudwatchLogSubscriptionFilterImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -29,9 +33,10 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				ForceNew: true,
 			},
 			"destination_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnService("kinesis", "firehose", "lambda", "logs"),
 			},
 			"filter_pattern": {
 				Type:     schema.TypeString,
@@ -44,13 +49,19 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				ForceNew: true,
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"distribution": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Default:  cloudwatchlogs.DistributionByLogStream,
+				ValidateFunc: validation.StringInSlice([]string{
+					cloudwatchlogs.DistributionRandom,
+					cloudwatchlogs.DistributionByLogStream,
+				}, false),
 			},
 		},
 	}
@@ -154,8 +165,12 @@ func resourceAwsCloudwatchLogSubscriptionFilterRead(d *schema.ResourceData, meta
 	}
 
 	for _, subscriptionFilter := range resp.SubscriptionFilters {
-		if *subscriptionFilter.LogGroupName == log_group_name {
+		if *subscriptionFilter.LogGroupName == log_group_name && aws.StringValue(subscriptionFilter.FilterName) == name {
 			d.SetId(cloudwatchLogsSubscriptionFilterId(log_group_name))
+			d.Set("destination_arn", subscriptionFilter.DestinationArn)
+			d.Set("distribution", subscriptionFilter.Distribution)
+			d.Set("filter_pattern", subscriptionFilter.FilterPattern)
+			d.Set("role_arn", subscriptionFilter.RoleArn)
 			return nil // OK, matching subscription filter found
 		}
 	}
@@ -184,6 +199,22 @@ func resourceAwsCloudwatchLogSubscriptionFilterDelete(d *schema.ResourceData, me
 	return nil
 }
 
+func resourceAwsCloudwatchLogSubscriptionFilterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "|")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <log-group-name>|<filter-name>", d.Id())
+	}
+
+	logGroupName := idParts[0]
+	filterName := idParts[1]
+
+	d.Set("log_group_name", logGroupName)
+	d.Set("name", filterName)
+	d.SetId(cloudwatchLogsSubscriptionFilterId(logGroupName))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func cloudwatchLogsSubscriptionFilterId(log_group_name string) string {
 	var buf bytes.Buffer
 
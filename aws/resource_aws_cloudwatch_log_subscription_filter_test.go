@@ -35,10 +35,27 @@ func TestAccAWSCloudwatchLogSubscriptionFilter_basic(t *testing.T) {
 						"aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", "distribution", "Random"),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSCloudwatchLogSubscriptionFilterImportStateIdFunc("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSCloudwatchLogSubscriptionFilterImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s|%s", rs.Primary.Attributes["log_group_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func TestAccAWSCloudwatchLogSubscriptionFilter_subscriptionFilterDisappears(t *testing.T) {
 	var filter cloudwatchlogs.SubscriptionFilter
 	rstring := acctest.RandString(5)
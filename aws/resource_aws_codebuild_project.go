@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/terraform/helper/hashcode"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -272,31 +271,17 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
 	}
 
-	var resp *codebuild.CreateProjectOutput
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		var err error
-
-		resp, err = conn.CreateProject(params)
-		if err != nil {
-			// Work around eventual consistency of IAM
-			if isAWSErr(err, "InvalidInputException", "CodeBuild is not authorized to perform") {
-				return resource.RetryableError(err)
-			}
-
-			if isAWSErr(err, "InvalidInputException", "Not authorized to perform DescribeSecurityGroups") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-
+	// Work around eventual consistency of IAM
+	raw, err := retryOnIamPropagation(5*time.Minute, []awsErrMatcher{
+		{"InvalidInputException", "CodeBuild is not authorized to perform"},
+		{"InvalidInputException", "Not authorized to perform DescribeSecurityGroups"},
+	}, func() (interface{}, error) {
+		return conn.CreateProject(params)
 	})
-
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating CodeBuild project: %s", err)
 	}
+	resp := raw.(*codebuild.CreateProjectOutput)
 
 	d.SetId(*resp.Project.Arn)
 
@@ -39,9 +39,10 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 						},
 
 						"destination_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArnService("sns", "lambda"),
 						},
 
 						"custom_data": &schema.Schema{
@@ -4,12 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"log"
-	"regexp"
 	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -140,8 +138,9 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 			},
 
 			"service_role_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"alarm_configuration": &schema.Schema{
@@ -327,8 +326,9 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 						},
 
 						"trigger_target_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 					},
 				},
@@ -338,6 +338,14 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 	}
 }
 
+// codeDeployDeploymentGroupIamPropagationErrors are returned by
+// CreateDeploymentGroup and UpdateDeploymentGroup until the service role, or
+// the policy of a trigger's SNS topic, has propagated.
+var codeDeployDeploymentGroupIamPropagationErrors = []awsErrMatcher{
+	{"InvalidRoleException", ""},
+	{"InvalidTriggerConfigException", "Topic ARN"},
+}
+
 func resourceAwsCodeDeployDeploymentGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codedeployconn
 
@@ -394,38 +402,13 @@ func resourceAwsCodeDeployDeploymentGroupCreate(d *schema.ResourceData, meta int
 	}
 
 	// Retry to handle IAM role eventual consistency.
-	var resp *codedeploy.CreateDeploymentGroupOutput
-	var err error
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		resp, err = conn.CreateDeploymentGroup(&input)
-		if err != nil {
-			retry := false
-			codedeployErr, ok := err.(awserr.Error)
-			if !ok {
-				return resource.NonRetryableError(err)
-			}
-			if codedeployErr.Code() == "InvalidRoleException" {
-				retry = true
-			}
-			if codedeployErr.Code() == "InvalidTriggerConfigException" {
-				r := regexp.MustCompile("^Topic ARN .+ is not valid$")
-				if r.MatchString(codedeployErr.Message()) {
-					retry = true
-				}
-			}
-			if retry {
-				log.Printf("[DEBUG] Trying to create deployment group again: %q",
-					codedeployErr.Message())
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	raw, err := retryOnIamPropagation(5*time.Minute, codeDeployDeploymentGroupIamPropagationErrors, func() (interface{}, error) {
+		return conn.CreateDeploymentGroup(&input)
 	})
 	if err != nil {
 		return err
 	}
+	resp := raw.(*codedeploy.CreateDeploymentGroupOutput)
 
 	d.SetId(*resp.DeploymentGroupId)
 
@@ -561,34 +544,9 @@ func resourceAwsCodeDeployDeploymentGroupUpdate(d *schema.ResourceData, meta int
 
 	log.Printf("[DEBUG] Updating CodeDeploy DeploymentGroup %s", d.Id())
 	// Retry to handle IAM role eventual consistency.
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, err := conn.UpdateDeploymentGroup(&input)
-		if err != nil {
-			retry := false
-			codedeployErr, ok := err.(awserr.Error)
-			if !ok {
-				return resource.NonRetryableError(err)
-			}
-			if codedeployErr.Code() == "InvalidRoleException" {
-				retry = true
-			}
-			if codedeployErr.Code() == "InvalidTriggerConfigException" {
-				r := regexp.MustCompile("^Topic ARN .+ is not valid$")
-				if r.MatchString(codedeployErr.Message()) {
-					retry = true
-				}
-			}
-			if retry {
-				log.Printf("[DEBUG] Retrying Code Deployment Group Update: %q",
-					codedeployErr.Message())
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := retryOnIamPropagation(5*time.Minute, codeDeployDeploymentGroupIamPropagationErrors, func() (interface{}, error) {
+		return conn.UpdateDeploymentGroup(&input)
 	})
-
 	if err != nil {
 		return err
 	}
@@ -72,7 +72,7 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validateArn,
+					ValidateFunc: validateArnServiceResource("iam", "oidc-provider/"),
 				},
 			},
 
@@ -81,7 +81,7 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validateArn,
+					ValidateFunc: validateArnServiceResource("iam", "saml-provider/"),
 				},
 			},
 
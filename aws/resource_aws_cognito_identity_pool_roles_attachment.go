@@ -71,7 +71,7 @@ func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validateArn,
+										ValidateFunc: validateIamRoleArn,
 									},
 									"value": {
 										Type:         schema.TypeString,
@@ -101,12 +101,12 @@ func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"authenticated": {
 							Type:         schema.TypeString,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 							Optional:     true, // Required if unauthenticated isn't defined.
 						},
 						"unauthenticated": {
 							Type:         schema.TypeString,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 							Optional:     true, // Required if authenticated isn't defined.
 						},
 					},
@@ -42,7 +42,7 @@ func resourceAwsCognitoUserGroup() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"user_pool_id": {
 				Type:         schema.TypeString,
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -143,7 +142,7 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 						"source_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateArnServiceResource("ses", "identity/"),
 						},
 					},
 				},
@@ -173,47 +172,47 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 						"create_auth_challenge": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"custom_message": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"define_auth_challenge": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"post_authentication": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"post_confirmation": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"pre_authentication": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"pre_sign_up": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"pre_token_generation": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"verify_auth_challenge_response": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 					},
 				},
@@ -376,7 +375,7 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 						"sns_caller_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -457,6 +456,13 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 	}
 }
 
+// cognitoUserPoolIamPropagationErrors are returned by CreateUserPool and
+// UpdateUserPool until the SMS role and its policy have propagated.
+var cognitoUserPoolIamPropagationErrors = []awsErrMatcher{
+	{"InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role"},
+	{"InvalidSmsRoleAccessPolicyException", "Role does not have permission to publish with SNS"},
+}
+
 func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cognitoidpconn
 
@@ -592,24 +598,13 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 
 	// IAM roles & policies can take some time to propagate and be attached
 	// to the User Pool
-	var resp *cognitoidentityprovider.CreateUserPoolOutput
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = conn.CreateUserPool(params)
-		if isAWSErr(err, "InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role") {
-			log.Printf("[DEBUG] Received %s, retrying CreateUserPool", err)
-			return resource.RetryableError(err)
-		}
-		if isAWSErr(err, "InvalidSmsRoleAccessPolicyException", "Role does not have permission to publish with SNS") {
-			log.Printf("[DEBUG] Received %s, retrying CreateUserPool", err)
-			return resource.RetryableError(err)
-		}
-
-		return resource.NonRetryableError(err)
+	raw, err := retryOnIamPropagation(iamPropagationTimeout, cognitoUserPoolIamPropagationErrors, func() (interface{}, error) {
+		return conn.CreateUserPool(params)
 	})
 	if err != nil {
 		return errwrap.Wrapf("Error creating Cognito User Pool: {{err}}", err)
 	}
+	resp := raw.(*cognitoidentityprovider.CreateUserPoolOutput)
 
 	d.SetId(*resp.UserPool.Id)
 
@@ -845,19 +840,8 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 
 	// IAM roles & policies can take some time to propagate and be attached
 	// to the User Pool.
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		_, err = conn.UpdateUserPool(params)
-		if isAWSErr(err, "InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role") {
-			log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
-			return resource.RetryableError(err)
-		}
-		if isAWSErr(err, "InvalidSmsRoleAccessPolicyException", "Role does not have permission to publish with SNS") {
-			log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
-			return resource.RetryableError(err)
-		}
-
-		return resource.NonRetryableError(err)
+	_, err := retryOnIamPropagation(iamPropagationTimeout, cognitoUserPoolIamPropagationErrors, func() (interface{}, error) {
+		return conn.UpdateUserPool(params)
 	})
 	if err != nil {
 		return errwrap.Wrapf("Error updating Cognito User pool: {{err}}", err)
@@ -168,23 +168,14 @@ func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) er
 		ConfigRule: &ruleInput,
 	}
 	log.Printf("[DEBUG] Creating AWSConfig config rule: %s", input)
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutConfigRule(&input)
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				if awsErr.Code() == "InsufficientPermissionsException" {
-					// IAM is eventually consistent
-					return resource.RetryableError(err)
-				}
-			}
-
-			return resource.NonRetryableError(fmt.Errorf("Failed to create AWSConfig rule: %s", err))
-		}
-
-		return nil
+	// IAM is eventually consistent
+	_, err := retryOnIamPropagation(iamPropagationTimeout, []awsErrMatcher{
+		{"InsufficientPermissionsException", ""},
+	}, func() (interface{}, error) {
+		return conn.PutConfigRule(&input)
 	})
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed to create AWSConfig rule: %s", err)
 	}
 
 	d.SetId(name)
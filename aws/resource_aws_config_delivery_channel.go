@@ -43,7 +43,7 @@ func resourceAwsConfigDeliveryChannel() *schema.Resource {
 			"sns_topic_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"snapshot_delivery_properties": {
 				Type:     schema.TypeList,
@@ -8,10 +8,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dax/waiter"
 )
 
 func resourceAwsDaxCluster() *schema.Resource {
@@ -25,9 +25,9 @@ func resourceAwsDaxCluster() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
-			Delete: schema.DefaultTimeout(45 * time.Minute),
-			Update: schema.DefaultTimeout(90 * time.Minute),
+			Create: schema.DefaultTimeout(waiter.ClusterCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.ClusterDeletedTimeout),
+			Update: schema.DefaultTimeout(waiter.ClusterUpdatedTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,7 +49,7 @@ func resourceAwsDaxCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"node_type": {
 				Type:     schema.TypeString,
@@ -72,8 +72,9 @@ func resourceAwsDaxCluster() *schema.Resource {
 				Optional: true,
 			},
 			"notification_topic_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnServiceResource("sns"),
 			},
 			"parameter_group_name": {
 				Type:     schema.TypeString,
@@ -154,7 +155,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIdSet := d.Get("security_group_ids").(*schema.Set)
 
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapDax(d.Get("tags").(map[string]interface{}))
+	tags := newKeyValueTags(d.Get("tags").(map[string]interface{})).IgnoreAws().daxTags()
 
 	req := &dax.CreateClusterInput{
 		ClusterName:       aws.String(clusterName),
@@ -190,22 +191,15 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// IAM roles take some time to propagate
-	var resp *dax.CreateClusterOutput
-	err := resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		resp, err = conn.CreateCluster(req)
-		if err != nil {
-			if isAWSErr(err, dax.ErrCodeInvalidParameterValueException, "No permission to assume role") {
-				log.Print("[DEBUG] Retrying create of DAX cluster")
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	raw, err := retryOnIamPropagation(d.Timeout(schema.TimeoutCreate), []awsErrMatcher{
+		{dax.ErrCodeInvalidParameterValueException, "No permission to assume role"},
+	}, func() (interface{}, error) {
+		return conn.CreateCluster(req)
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating DAX cluster: %s", err)
 	}
+	resp := raw.(*dax.CreateClusterOutput)
 
 	// Assign the cluster id as the resource ID
 	// DAX always retains the id in lower case, so we have to
@@ -213,20 +207,9 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	// name contained uppercase characters.
 	d.SetId(strings.ToLower(*resp.Cluster.ClusterName))
 
-	pending := []string{"creating", "modifying"}
-	stateConf := &resource.StateChangeConf{
-		Pending:    pending,
-		Target:     []string{"available"},
-		Refresh:    daxClusterStateRefreshFunc(conn, d.Id(), "available", pending),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	log.Printf("[DEBUG] Waiting for state to become available: %v", d.Id())
-	_, sterr := stateConf.WaitForState()
-	if sterr != nil {
-		return fmt.Errorf("Error waiting for DAX cluster (%s) to be created: %s", d.Id(), sterr)
+	log.Printf("[DEBUG] Waiting for DAX cluster (%s) to become available", d.Id())
+	if _, err := waiter.ClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for DAX cluster (%s) to be created: %s", d.Id(), err)
 	}
 
 	return resourceAwsDaxClusterRead(d, meta)
@@ -278,35 +261,25 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("maintenance_window", c.PreferredMaintenanceWindow)
 
-	if c.NotificationConfiguration != nil {
-		if *c.NotificationConfiguration.TopicStatus == "active" {
-			d.Set("notification_topic_arn", c.NotificationConfiguration.TopicArn)
-		}
+	notificationTopicArn := ""
+	if c.NotificationConfiguration != nil && aws.StringValue(c.NotificationConfiguration.TopicStatus) == "active" {
+		notificationTopicArn = aws.StringValue(c.NotificationConfiguration.TopicArn)
 	}
+	d.Set("notification_topic_arn", notificationTopicArn)
 
 	if err := setDaxClusterNodeData(d, c); err != nil {
 		return err
 	}
 
-	// list tags for resource
-	// set tags
-	arn, err := buildDaxArn(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
+	tagsResp, err := conn.ListTags(&dax.ListTagsInput{
+		ResourceName: c.ClusterArn,
+	})
 	if err != nil {
-		log.Printf("[DEBUG] Error building ARN for DAX Cluster, not setting Tags for cluster %s", *c.ClusterName)
-	} else {
-		resp, err := conn.ListTags(&dax.ListTagsInput{
-			ResourceName: aws.String(arn),
-		})
-
-		if err != nil {
-			log.Printf("[DEBUG] Error retrieving tags for ARN: %s", arn)
-		}
+		return fmt.Errorf("error listing tags for DAX Cluster (%s): %s", d.Id(), err)
+	}
 
-		var dt []*dax.Tag
-		if len(resp.Tags) > 0 {
-			dt = resp.Tags
-		}
-		d.Set("tags", tagsToMapDax(dt))
+	if err := d.Set("tags", daxKeyValueTags(tagsResp.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -314,12 +287,11 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).daxconn
-	arn, err := buildDaxArn(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region)
-	if err != nil {
-		log.Printf("[DEBUG] Error building ARN for DAX Cluster, not updating Tags for cluster %s", d.Id())
-	} else {
-		if err := setTagsDax(conn, d, arn); err != nil {
-			return err
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := daxUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DAX Cluster (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -352,11 +324,14 @@ func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if d.HasChange("notification_topic_arn") {
-		v := d.Get("notification_topic_arn").(string)
-		req.NotificationTopicArn = aws.String(v)
-		if v == "" {
-			inactive := "inactive"
-			req.NotificationTopicStatus = &inactive
+		o, n := d.GetChange("notification_topic_arn")
+		if v := n.(string); v != "" {
+			req.NotificationTopicArn = aws.String(v)
+			req.NotificationTopicStatus = aws.String("active")
+		} else {
+			// Notifications are disabled by deactivating the existing topic
+			req.NotificationTopicArn = aws.String(o.(string))
+			req.NotificationTopicStatus = aws.String("inactive")
 		}
 		requestUpdate = true
 	}
@@ -387,10 +362,19 @@ func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		if n > o {
 			log.Printf("[INFO] Increasing nodes in DAX cluster %s from %d to %d", d.Id(), o, n)
-			_, err := conn.IncreaseReplicationFactor(&dax.IncreaseReplicationFactorInput{
+			input := &dax.IncreaseReplicationFactorInput{
 				ClusterName:          aws.String(d.Id()),
 				NewReplicationFactor: aws.Int64(int64(nraw.(int))),
-			})
+			}
+			// IncreaseReplicationFactor takes one Availability Zone per node
+			// being added, so the configured zones can only be used for
+			// placement when there is exactly one for each new node.
+			if v := d.Get("availability_zones").(*schema.Set); v.Len() == n-o {
+				input.AvailabilityZones = expandStringList(v.List())
+			} else if v.Len() > 0 {
+				log.Printf("[DEBUG] Not placing %d new nodes in DAX cluster %s in the %d configured Availability Zones", n-o, d.Id(), v.Len())
+			}
+			_, err := conn.IncreaseReplicationFactor(input)
 			if err != nil {
 				return fmt.Errorf("[WARN] Error increasing nodes in DAX cluster %s, error: %s", d.Id(), err)
 			}
@@ -400,19 +384,8 @@ func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if awaitUpdate {
 		log.Printf("[DEBUG] Waiting for update: %s", d.Id())
-		pending := []string{"modifying"}
-		stateConf := &resource.StateChangeConf{
-			Pending:    pending,
-			Target:     []string{"available"},
-			Refresh:    daxClusterStateRefreshFunc(conn, d.Id(), "available", pending),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second,
-		}
-
-		_, sterr := stateConf.WaitForState()
-		if sterr != nil {
-			return fmt.Errorf("Error waiting for DAX (%s) to update: %s", d.Id(), sterr)
+		if _, err := waiter.ClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for DAX (%s) to update: %s", d.Id(), err)
 		}
 	}
 
@@ -424,21 +397,22 @@ func setDaxClusterNodeData(d *schema.ResourceData, c *dax.Cluster) error {
 	copy(sortedNodes, c.Nodes)
 	sort.Sort(byNodeId(sortedNodes))
 
-	nodeDate := make([]map[string]interface{}, 0, len(sortedNodes))
+	nodeData := make([]map[string]interface{}, 0, len(sortedNodes))
 
+	// Nodes being added to the cluster do not have an endpoint yet
 	for _, node := range sortedNodes {
-		if node.NodeId == nil || node.Endpoint == nil || node.Endpoint.Address == nil || node.Endpoint.Port == nil || node.AvailabilityZone == nil {
-			return fmt.Errorf("Unexpected nil pointer in: %s", node)
+		m := map[string]interface{}{
+			"id":                aws.StringValue(node.NodeId),
+			"availability_zone": aws.StringValue(node.AvailabilityZone),
 		}
-		nodeDate = append(nodeDate, map[string]interface{}{
-			"id":                *node.NodeId,
-			"address":           *node.Endpoint.Address,
-			"port":              int(*node.Endpoint.Port),
-			"availability_zone": *node.AvailabilityZone,
-		})
+		if node.Endpoint != nil {
+			m["address"] = aws.StringValue(node.Endpoint.Address)
+			m["port"] = int(aws.Int64Value(node.Endpoint.Port))
+		}
+		nodeData = append(nodeData, m)
 	}
 
-	return d.Set("nodes", nodeDate)
+	return d.Set("nodes", nodeData)
 }
 
 type byNodeId []*dax.Node
@@ -471,118 +445,11 @@ func resourceAwsDaxClusterDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Waiting for deletion: %v", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"creating", "available", "deleting", "incompatible-parameters", "incompatible-network"},
-		Target:     []string{},
-		Refresh:    daxClusterStateRefreshFunc(conn, d.Id(), "", []string{}),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	_, sterr := stateConf.WaitForState()
-	if sterr != nil {
-		return fmt.Errorf("Error waiting for DAX (%s) to delete: %s", d.Id(), sterr)
+	if _, err := waiter.ClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for DAX (%s) to delete: %s", d.Id(), err)
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func daxClusterStateRefreshFunc(conn *dax.DAX, clusterID, givenState string, pending []string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
-			ClusterNames: []*string{aws.String(clusterID)},
-		})
-		if err != nil {
-			if isAWSErr(err, dax.ErrCodeClusterNotFoundFault, "") {
-				log.Printf("[DEBUG] Detect deletion")
-				return nil, "", nil
-			}
-
-			log.Printf("[ERROR] daxClusterStateRefreshFunc: %s", err)
-			return nil, "", err
-		}
-
-		if len(resp.Clusters) == 0 {
-			return nil, "", fmt.Errorf("[WARN] Error: no DAX clusters found for id (%s)", clusterID)
-		}
-
-		var c *dax.Cluster
-		for _, cluster := range resp.Clusters {
-			if *cluster.ClusterName == clusterID {
-				log.Printf("[DEBUG] Found matching DAX cluster: %s", *cluster.ClusterName)
-				c = cluster
-			}
-		}
-
-		if c == nil {
-			return nil, "", fmt.Errorf("[WARN] Error: no matching DAX cluster for id (%s)", clusterID)
-		}
-
-		// DescribeCluster returns a response without status late on in the
-		// deletion process - assume cluster is still deleting until we
-		// get ClusterNotFoundFault
-		if c.Status == nil {
-			log.Printf("[DEBUG] DAX Cluster %s has no status attribute set - assume status is deleting", clusterID)
-			return c, "deleting", nil
-		}
-
-		log.Printf("[DEBUG] DAX Cluster (%s) status: %v", clusterID, *c.Status)
-
-		// return the current state if it's in the pending array
-		for _, p := range pending {
-			log.Printf("[DEBUG] DAX: checking pending state (%s) for cluster (%s), cluster status: %s", pending, clusterID, *c.Status)
-			s := *c.Status
-			if p == s {
-				log.Printf("[DEBUG] Return with status: %v", *c.Status)
-				return c, p, nil
-			}
-		}
-
-		// return given state if it's not in pending
-		if givenState != "" {
-			log.Printf("[DEBUG] DAX: checking given state (%s) of cluster (%s) against cluster status (%s)", givenState, clusterID, *c.Status)
-			// check to make sure we have the node count we're expecting
-			if int64(len(c.Nodes)) != *c.TotalNodes {
-				log.Printf("[DEBUG] Node count is not what is expected: %d found, %d expected", len(c.Nodes), *c.TotalNodes)
-				return nil, "creating", nil
-			}
-
-			log.Printf("[DEBUG] Node count matched (%d)", len(c.Nodes))
-			// loop the nodes and check their status as well
-			for _, n := range c.Nodes {
-				log.Printf("[DEBUG] Checking cache node for status: %s", n)
-				if n.NodeStatus != nil && *n.NodeStatus != "available" {
-					log.Printf("[DEBUG] Node (%s) is not yet available, status: %s", *n.NodeId, *n.NodeStatus)
-					return nil, "creating", nil
-				}
-				log.Printf("[DEBUG] Cache node not in expected state")
-			}
-			log.Printf("[DEBUG] DAX returning given state (%s), cluster: %s", givenState, c)
-			return c, givenState, nil
-		}
-		log.Printf("[DEBUG] current status: %v", *c.Status)
-		return c, *c.Status, nil
-	}
-}
-
-func buildDaxArn(identifier, partition, accountid, region string) (string, error) {
-	if partition == "" {
-		return "", fmt.Errorf("Unable to construct DAX ARN because of missing AWS partition")
-	}
-	if accountid == "" {
-		return "", fmt.Errorf("Unable to construct DAX ARN because of missing AWS Account ID")
-	}
-
-	arn := arn.ARN{
-		Partition: partition,
-		Service:   "dax",
-		Region:    region,
-		AccountID: accountid,
-		Resource:  fmt.Sprintf("cache/%s", identifier),
-	}
-
-	return arn.String(), nil
-}
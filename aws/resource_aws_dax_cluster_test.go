@@ -9,9 +9,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dax/waiter"
 )
 
 func init() {
@@ -28,33 +30,63 @@ func testSweepDAXClusters(region string) error {
 	}
 	conn := client.(*AWSClient).daxconn
 
-	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{})
-	if err != nil {
-		return fmt.Errorf("Error retrieving DAX clusters: %s", err)
+	var clusterNames []string
+	input := &dax.DescribeClustersInput{}
+	for {
+		resp, err := conn.DescribeClusters(input)
+		if err != nil {
+			return fmt.Errorf("Error retrieving DAX clusters: %s", err)
+		}
+
+		for _, cluster := range resp.Clusters {
+			if !strings.HasPrefix(*cluster.ClusterName, "tf-") {
+				continue
+			}
+			clusterNames = append(clusterNames, *cluster.ClusterName)
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
 	}
 
-	if len(resp.Clusters) == 0 {
+	if len(clusterNames) == 0 {
 		log.Print("[DEBUG] No DAX clusters to sweep")
 		return nil
 	}
 
-	log.Printf("[INFO] Found %d DAX clusters", len(resp.Clusters))
+	log.Printf("[INFO] Found %d DAX clusters", len(clusterNames))
 
-	for _, cluster := range resp.Clusters {
-		if !strings.HasPrefix(*cluster.ClusterName, "tf-") {
-			continue
+	if testSweepDryRun() {
+		for _, clusterName := range clusterNames {
+			log.Printf("[INFO] Would delete DAX cluster %s", clusterName)
 		}
+		return nil
+	}
 
-		log.Printf("[INFO] Deleting DAX cluster %s", *cluster.ClusterName)
+	// Start all deletions before waiting, as each one takes several minutes.
+	var errors error
+	var deleted []string
+	for _, clusterName := range clusterNames {
+		log.Printf("[INFO] Deleting DAX cluster %s", clusterName)
 		_, err := conn.DeleteCluster(&dax.DeleteClusterInput{
-			ClusterName: cluster.ClusterName,
+			ClusterName: aws.String(clusterName),
 		})
 		if err != nil {
-			return fmt.Errorf("Error deleting DAX cluster %s: %s", *cluster.ClusterName, err)
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting DAX cluster %s: %s", clusterName, err))
+			continue
 		}
+		deleted = append(deleted, clusterName)
 	}
 
-	return nil
+	for _, clusterName := range deleted {
+		if _, err := waiter.ClusterDeleted(conn, clusterName, waiter.ClusterDeletedTimeout); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error waiting for DAX cluster %s to delete: %s", clusterName, err))
+		}
+	}
+
+	return errors
 }
 
 func TestAccAWSDAXCluster_basic(t *testing.T) {
@@ -123,6 +155,8 @@ func TestAccAWSDAXCluster_resize(t *testing.T) {
 					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &dc),
 					resource.TestCheckResourceAttr(
 						"aws_dax_cluster.test", "replication_factor", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "nodes.#", "2"),
 				),
 			},
 			{
@@ -137,6 +171,166 @@ func TestAccAWSDAXCluster_resize(t *testing.T) {
 	})
 }
 
+func TestAccAWSDAXCluster_updateInPlace(t *testing.T) {
+	var before, after dax.Cluster
+	rString := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXClusterConfigUpdateInPlace(rString, "first", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &before),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "notification_topic_arn", ""),
+				),
+			},
+			{
+				Config: testAccAWSDAXClusterConfigUpdateInPlace(rString, "second", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &after),
+					testAccCheckAWSDAXClusterNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"aws_dax_cluster.test", "notification_topic_arn",
+						"aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSDAXClusterConfigUpdateInPlace(rString, "second", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &after),
+					testAccCheckAWSDAXClusterNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "notification_topic_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDAXClusterNotRecreated(before, after *dax.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// The cluster ARN is derived from its name, so compare node creation
+		// times to detect replacement
+		if len(before.Nodes) == 0 || len(after.Nodes) == 0 {
+			return fmt.Errorf("DAX cluster (%s) has no nodes", aws.StringValue(after.ClusterName))
+		}
+		if !aws.TimeValue(before.Nodes[0].NodeCreateTime).Equal(aws.TimeValue(after.Nodes[0].NodeCreateTime)) {
+			return fmt.Errorf("DAX cluster (%s) was recreated", aws.StringValue(after.ClusterName))
+		}
+		return nil
+	}
+}
+
+func TestAccAWSDAXCluster_tags(t *testing.T) {
+	var before, after dax.Cluster
+	rString := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXClusterConfig(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &before),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccAWSDAXClusterConfigTags(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &after),
+					testAccCheckAWSDAXClusterNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "tags.foo", "baz"),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "tags.env", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDAXCluster_availabilityZones(t *testing.T) {
+	var dc dax.Cluster
+	rString := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXClusterConfigAvailabilityZones(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &dc),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "nodes.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"aws_dax_cluster.test", "nodes.0.availability_zone"),
+					resource.TestCheckResourceAttrSet(
+						"aws_dax_cluster.test", "nodes.1.availability_zone"),
+					resource.TestCheckResourceAttrSet(
+						"aws_dax_cluster.test", "nodes.0.address"),
+					resource.TestMatchResourceAttr(
+						"aws_dax_cluster.test", "nodes.0.port", regexp.MustCompile("^\\d+$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDAXCluster_availabilityZonesScaleUp(t *testing.T) {
+	var before, after dax.Cluster
+	rString := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXClusterConfigAvailabilityZonesScaleUp(rString, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &before),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "nodes.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"aws_dax_cluster.test", "nodes.0.availability_zone",
+						"data.aws_availability_zones.available", "names.0"),
+				),
+			},
+			{
+				Config: testAccAWSDAXClusterConfigAvailabilityZonesScaleUp(rString, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists("aws_dax_cluster.test", &after),
+					testAccCheckAWSDAXClusterNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(
+						"aws_dax_cluster.test", "nodes.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"aws_dax_cluster.test", "nodes.0.availability_zone",
+						"data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttrPair(
+						"aws_dax_cluster.test", "nodes.1.availability_zone",
+						"data.aws_availability_zones.available", "names.0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSDAXClusterDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).daxconn
 
@@ -246,6 +440,23 @@ func testAccAWSDAXClusterConfig(rString string) string {
 		`, baseConfig, rString)
 }
 
+func testAccAWSDAXClusterConfigTags(rString string) string {
+	return fmt.Sprintf(`%s
+		resource "aws_dax_cluster" "test" {
+		  cluster_name       = "tf-%s"
+		  iam_role_arn       = "${aws_iam_role.test.arn}"
+		  node_type          = "dax.r3.large"
+		  replication_factor = 1
+		  description        = "test cluster"
+
+		  tags {
+		    foo = "baz"
+		    env = "test"
+		  }
+		}
+		`, baseConfig, rString)
+}
+
 func testAccAWSDAXClusterConfigResize_singleNode(rString string) string {
 	return fmt.Sprintf(`%s
 		resource "aws_dax_cluster" "test" {
@@ -267,3 +478,68 @@ func testAccAWSDAXClusterConfigResize_multiNode(rString string) string {
 		}
 		`, baseConfig, rString)
 }
+
+func testAccAWSDAXClusterConfigAvailabilityZones(rString string) string {
+	return fmt.Sprintf(`%s
+		data "aws_availability_zones" "available" {}
+
+		resource "aws_dax_cluster" "test" {
+		  cluster_name       = "tf-%s"
+		  iam_role_arn       = "${aws_iam_role.test.arn}"
+		  node_type          = "dax.r3.large"
+		  replication_factor = 2
+
+		  availability_zones = [
+		    "${data.aws_availability_zones.available.names[0]}",
+		    "${data.aws_availability_zones.available.names[1]}",
+		  ]
+		}
+		`, baseConfig, rString)
+}
+
+func testAccAWSDAXClusterConfigAvailabilityZonesScaleUp(rString string, replicationFactor int) string {
+	return fmt.Sprintf(`%s
+		data "aws_availability_zones" "available" {}
+
+		resource "aws_dax_cluster" "test" {
+		  cluster_name       = "tf-%s"
+		  iam_role_arn       = "${aws_iam_role.test.arn}"
+		  node_type          = "dax.r3.large"
+		  replication_factor = %d
+
+		  availability_zones = [
+		    "${data.aws_availability_zones.available.names[0]}",
+		  ]
+		}
+		`, baseConfig, rString, replicationFactor)
+}
+
+func testAccAWSDAXClusterConfigUpdateInPlace(rString, securityGroup string, notifications bool) string {
+	notificationTopicArn := ""
+	if notifications {
+		notificationTopicArn = "${aws_sns_topic.test.arn}"
+	}
+
+	return fmt.Sprintf(`%s
+		resource "aws_security_group" "first" {
+		  name = "tf-%[2]s-first"
+		}
+
+		resource "aws_security_group" "second" {
+		  name = "tf-%[2]s-second"
+		}
+
+		resource "aws_sns_topic" "test" {
+		  name = "tf-%[2]s"
+		}
+
+		resource "aws_dax_cluster" "test" {
+		  cluster_name           = "tf-%[2]s"
+		  iam_role_arn           = "${aws_iam_role.test.arn}"
+		  node_type              = "dax.r3.large"
+		  replication_factor     = 1
+		  security_group_ids     = ["${aws_security_group.%[3]s.id}"]
+		  notification_topic_arn = "%[4]s"
+		}
+		`, baseConfig, rString, securityGroup, notificationTopicArn)
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDaxParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDaxParameterGroupCreate,
+		Read:   resourceAwsDaxParameterGroupRead,
+		Update: resourceAwsDaxParameterGroupUpdate,
+		Delete: resourceAwsDaxParameterGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDaxParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	input := &dax.CreateParameterGroupInput{
+		ParameterGroupName: aws.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DAX Parameter Group: %s", input)
+	_, err := conn.CreateParameterGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating DAX Parameter Group (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		return resourceAwsDaxParameterGroupUpdate(d, meta)
+	}
+
+	return resourceAwsDaxParameterGroupRead(d, meta)
+}
+
+func resourceAwsDaxParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	resp, err := conn.DescribeParameterGroups(&dax.DescribeParameterGroupsInput{
+		ParameterGroupNames: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
+		log.Printf("[WARN] DAX Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DAX Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.ParameterGroups) == 0 {
+		log.Printf("[WARN] DAX Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	pg := resp.ParameterGroups[0]
+	d.Set("name", pg.ParameterGroupName)
+	d.Set("description", pg.Description)
+
+	var parameters []*dax.Parameter
+	input := &dax.DescribeParametersInput{
+		ParameterGroupName: aws.String(d.Id()),
+	}
+	for {
+		out, err := conn.DescribeParameters(input)
+		if err != nil {
+			return fmt.Errorf("error reading DAX Parameter Group (%s) parameters: %s", d.Id(), err)
+		}
+		parameters = append(parameters, out.Parameters...)
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	// DAX parameter groups always contain every parameter and there is no
+	// way to reset one, so only track the configured parameters. All of them
+	// are tracked when nothing is configured, e.g. on import.
+	configured := make(map[string]bool)
+	for _, v := range d.Get("parameter").(*schema.Set).List() {
+		configured[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	if err := d.Set("parameter", flattenDaxParameters(parameters, configured)); err != nil {
+		return fmt.Errorf("error setting parameter: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDaxParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		toUpdate := expandDaxParameters(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		if len(toUpdate) > 0 {
+			input := &dax.UpdateParameterGroupInput{
+				ParameterGroupName:  aws.String(d.Id()),
+				ParameterNameValues: toUpdate,
+			}
+
+			log.Printf("[DEBUG] Updating DAX Parameter Group: %s", input)
+			_, err := conn.UpdateParameterGroup(input)
+			if err != nil {
+				return fmt.Errorf("error updating DAX Parameter Group (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsDaxParameterGroupRead(d, meta)
+}
+
+func resourceAwsDaxParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	log.Printf("[DEBUG] Deleting DAX Parameter Group: %s", d.Id())
+	_, err := conn.DeleteParameterGroup(&dax.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting DAX Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDaxParameters(l []interface{}) []*dax.ParameterNameValue {
+	params := make([]*dax.ParameterNameValue, 0, len(l))
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		params = append(params, &dax.ParameterNameValue{
+			ParameterName:  aws.String(m["name"].(string)),
+			ParameterValue: aws.String(m["value"].(string)),
+		})
+	}
+	return params
+}
+
+func flattenDaxParameters(params []*dax.Parameter, names map[string]bool) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(params))
+	for _, p := range params {
+		name := aws.StringValue(p.ParameterName)
+		if len(names) > 0 && !names[name] {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":  name,
+			"value": aws.StringValue(p.ParameterValue),
+		})
+	}
+	return result
+}
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDAXParameterGroup_basic(t *testing.T) {
+	resourceName := "aws_dax_parameter_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDAXParameterGroup_parameters(t *testing.T) {
+	resourceName := "aws_dax_parameter_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXParameterGroupConfigParameters(rName, "60000", "120000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test parameter group"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					testAccCheckAWSDAXParameterGroupParameter(resourceName, "query-ttl-millis", "60000"),
+					testAccCheckAWSDAXParameterGroupParameter(resourceName, "record-ttl-millis", "120000"),
+				),
+			},
+			{
+				Config: testAccAWSDAXParameterGroupConfigParameters(rName, "90000", "120000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					testAccCheckAWSDAXParameterGroupParameter(resourceName, "query-ttl-millis", "90000"),
+					testAccCheckAWSDAXParameterGroupParameter(resourceName, "record-ttl-millis", "120000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDAXParameterGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).daxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dax_parameter_group" {
+			continue
+		}
+
+		_, err := conn.DescribeParameterGroups(&dax.DescribeParameterGroupsInput{
+			ParameterGroupNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DAX Parameter Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDAXParameterGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DAX Parameter Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).daxconn
+
+		_, err := conn.DescribeParameterGroups(&dax.DescribeParameterGroupsInput{
+			ParameterGroupNames: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSDAXParameterGroupParameter(n, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).daxconn
+
+		resp, err := conn.DescribeParameters(&dax.DescribeParametersInput{
+			ParameterGroupName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, p := range resp.Parameters {
+			if aws.StringValue(p.ParameterName) != name {
+				continue
+			}
+			if v := aws.StringValue(p.ParameterValue); v != value {
+				return fmt.Errorf("DAX Parameter Group (%s) parameter %s is %q, expected %q", rs.Primary.ID, name, v, value)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("DAX Parameter Group (%s) parameter %s not found", rs.Primary.ID, name)
+	}
+}
+
+func testAccAWSDAXParameterGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dax_parameter_group" "test" {
+  name = "%s"
+}
+`, rName)
+}
+
+func testAccAWSDAXParameterGroupConfigParameters(rName, queryTtl, recordTtl string) string {
+	return fmt.Sprintf(`
+resource "aws_dax_parameter_group" "test" {
+  name        = "%s"
+  description = "test parameter group"
+
+  parameter {
+    name  = "query-ttl-millis"
+    value = "%s"
+  }
+
+  parameter {
+    name  = "record-ttl-millis"
+    value = "%s"
+  }
+}
+`, rName, queryTtl, recordTtl)
+}
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDaxSubnetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDaxSubnetGroupCreate,
+		Read:   resourceAwsDaxSubnetGroupRead,
+		Update: resourceAwsDaxSubnetGroupUpdate,
+		Delete: resourceAwsDaxSubnetGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDaxSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	input := &dax.CreateSubnetGroupInput{
+		SubnetGroupName: aws.String(d.Get("name").(string)),
+		SubnetIds:       expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DAX Subnet Group: %s", input)
+	_, err := conn.CreateSubnetGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating DAX Subnet Group (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceAwsDaxSubnetGroupRead(d, meta)
+}
+
+func resourceAwsDaxSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	resp, err := conn.DescribeSubnetGroups(&dax.DescribeSubnetGroupsInput{
+		SubnetGroupNames: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, dax.ErrCodeSubnetGroupNotFoundFault, "") {
+		log.Printf("[WARN] DAX Subnet Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading DAX Subnet Group (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.SubnetGroups) == 0 {
+		log.Printf("[WARN] DAX Subnet Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	sg := resp.SubnetGroups[0]
+	d.Set("name", sg.SubnetGroupName)
+	d.Set("description", sg.Description)
+	d.Set("vpc_id", sg.VpcId)
+
+	subnetIds := make([]string, 0, len(sg.Subnets))
+	for _, s := range sg.Subnets {
+		subnetIds = append(subnetIds, aws.StringValue(s.SubnetIdentifier))
+	}
+	if err := d.Set("subnet_ids", subnetIds); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDaxSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	input := &dax.UpdateSubnetGroupInput{
+		SubnetGroupName: aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("subnet_ids") {
+		input.SubnetIds = expandStringSet(d.Get("subnet_ids").(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Updating DAX Subnet Group: %s", input)
+	_, err := conn.UpdateSubnetGroup(input)
+	if err != nil {
+		return fmt.Errorf("error updating DAX Subnet Group (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsDaxSubnetGroupRead(d, meta)
+}
+
+func resourceAwsDaxSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+
+	log.Printf("[DEBUG] Deleting DAX Subnet Group: %s", d.Id())
+	_, err := conn.DeleteSubnetGroup(&dax.DeleteSubnetGroupInput{
+		SubnetGroupName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, dax.ErrCodeSubnetGroupNotFoundFault, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting DAX Subnet Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDAXSubnetGroup_basic(t *testing.T) {
+	resourceName := "aws_dax_subnet_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXSubnetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXSubnetGroupConfig(rName, "first", `["${aws_subnet.test1.id}", "${aws_subnet.test2.id}"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDAXSubnetGroupConfig(rName, "second", `["${aws_subnet.test1.id}"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXSubnetGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDAXSubnetGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).daxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dax_subnet_group" {
+			continue
+		}
+
+		_, err := conn.DescribeSubnetGroups(&dax.DescribeSubnetGroupsInput{
+			SubnetGroupNames: []*string{aws.String(rs.Primary.ID)},
+		})
+		if isAWSErr(err, dax.ErrCodeSubnetGroupNotFoundFault, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DAX Subnet Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDAXSubnetGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DAX Subnet Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).daxconn
+
+		_, err := conn.DescribeSubnetGroups(&dax.DescribeSubnetGroupsInput{
+			SubnetGroupNames: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		return err
+	}
+}
+
+func testAccAWSDAXSubnetGroupConfig(rName, description, subnetIds string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-dax-subnet-group"
+  }
+}
+
+resource "aws_subnet" "test1" {
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-dax-subnet-group-1"
+  }
+}
+
+resource "aws_subnet" "test2" {
+  cidr_block        = "10.0.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "tf-acc-dax-subnet-group-2"
+  }
+}
+
+resource "aws_dax_subnet_group" "test" {
+  name        = "%s"
+  description = "%s"
+  subnet_ids  = %s
+}
+`, rName, description, subnetIds)
+}
@@ -303,9 +303,10 @@ func resourceAwsDbInstance() *schema.Resource {
 			},
 
 			"monitoring_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"monitoring_interval": {
@@ -325,7 +326,7 @@ func resourceAwsDbInstance() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			"timezone": {
@@ -10,13 +10,13 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/acctest"
-	"github.com/hashicorp/terraform/helper/resource"
-	"github.com/hashicorp/terraform/terraform"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func init() {
@@ -41,34 +41,15 @@ func testSweepDbInstances(region string) error {
 		"tf-",
 	}
 
+	var ids []string
 	err = conn.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(out *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 		for _, dbi := range out.DBInstances {
-			hasPrefix := false
 			for _, prefix := range prefixes {
 				if strings.HasPrefix(*dbi.DBInstanceIdentifier, prefix) {
-					hasPrefix = true
+					ids = append(ids, *dbi.DBInstanceIdentifier)
+					break
 				}
 			}
-			if !hasPrefix {
-				continue
-			}
-			log.Printf("[INFO] Deleting DB instance: %s", *dbi.DBInstanceIdentifier)
-
-			_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
-				DBInstanceIdentifier: dbi.DBInstanceIdentifier,
-				SkipFinalSnapshot:    aws.Bool(true),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB instance %s: %s",
-					*dbi.DBInstanceIdentifier, err)
-				continue
-			}
-
-			err = waitUntilAwsDbInstanceIsDeleted(*dbi.DBInstanceIdentifier, conn, 40*time.Minute)
-			if err != nil {
-				log.Printf("[ERROR] Failure while waiting for DB instance %s to be deleted: %s",
-					*dbi.DBInstanceIdentifier, err)
-			}
 		}
 		return !lastPage
 	})
@@ -76,7 +57,37 @@ func testSweepDbInstances(region string) error {
 		return fmt.Errorf("Error retrieving DB instances: %s", err)
 	}
 
-	return nil
+	if testSweepDryRun() {
+		for _, id := range ids {
+			log.Printf("[INFO] Would delete DB instance: %s", id)
+		}
+		return nil
+	}
+
+	// Start all deletions before waiting, as each one takes several minutes.
+	var errors error
+	var deleted []string
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting DB instance: %s", id)
+		_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Failed to delete DB instance %s: %s", id, err))
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+
+	for _, id := range deleted {
+		err := waitUntilAwsDbInstanceIsDeleted(id, conn, 40*time.Minute)
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Failure while waiting for DB instance %s to be deleted: %s", id, err))
+		}
+	}
+
+	return errors
 }
 
 func TestAccAWSDBInstance_basic(t *testing.T) {
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -20,6 +21,9 @@ func init() {
 	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    testSweepDbOptionGroups,
+		Dependencies: []string{
+			"aws_db_instance",
+		},
 	})
 }
 
@@ -31,26 +35,33 @@ func testSweepDbOptionGroups(region string) error {
 
 	conn := client.(*AWSClient).rdsconn
 
+	var names []string
 	opts := rds.DescribeOptionGroupsInput{}
-	resp, err := conn.DescribeOptionGroups(&opts)
+	err = conn.DescribeOptionGroupsPages(&opts, func(page *rds.DescribeOptionGroupsOutput, lastPage bool) bool {
+		for _, og := range page.OptionGroupsList {
+			for _, testName := range []string{"option-group-test-terraform-", "tf-test"} {
+				if strings.HasPrefix(*og.OptionGroupName, testName) {
+					names = append(names, *og.OptionGroupName)
+					break
+				}
+			}
+		}
+		return !lastPage
+	})
 	if err != nil {
 		return fmt.Errorf("error describing DB Option Groups in Sweeper: %s", err)
 	}
 
-	for _, og := range resp.OptionGroupsList {
-		var testOptGroup bool
-		for _, testName := range []string{"option-group-test-terraform-", "tf-test"} {
-			if strings.HasPrefix(*og.OptionGroupName, testName) {
-				testOptGroup = true
-			}
-		}
-
-		if !testOptGroup {
+	var errors error
+	for _, name := range names {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete DB Option Group: %s", name)
 			continue
 		}
 
+		log.Printf("[INFO] Deleting DB Option Group: %s", name)
 		deleteOpts := &rds.DeleteOptionGroupInput{
-			OptionGroupName: og.OptionGroupName,
+			OptionGroupName: aws.String(name),
 		}
 
 		ret := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -67,11 +78,11 @@ func testSweepDbOptionGroups(region string) error {
 			return nil
 		})
 		if ret != nil {
-			return fmt.Errorf("Error Deleting DB Option Group (%s) in Sweeper: %s", *og.OptionGroupName, ret)
+			errors = multierror.Append(errors, fmt.Errorf("Error Deleting DB Option Group (%s) in Sweeper: %s", name, ret))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSDBOptionGroup_basic(t *testing.T) {
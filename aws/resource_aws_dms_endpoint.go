@@ -29,7 +29,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed:     true,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnServiceResource("dms", "cert:"),
 			},
 			"database_name": {
 				Type:     schema.TypeString,
@@ -83,7 +83,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 			"password": {
 				Type:      schema.TypeString,
@@ -25,6 +25,12 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cdc_start_time": {
 				Type:     schema.TypeString,
@@ -44,7 +50,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnServiceResource("dms", "rep:"),
 			},
 			"replication_task_arn": {
 				Type:     schema.TypeString,
@@ -66,7 +72,16 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnServiceResource("dms", "endpoint:"),
+			},
+			"start_replication_task": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"table_mappings": {
 				Type:             schema.TypeString,
@@ -82,7 +97,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnServiceResource("dms", "endpoint:"),
 			},
 		},
 	}
@@ -138,6 +153,12 @@ func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if d.Get("start_replication_task").(bool) {
+		if err := resourceAwsDmsReplicationTaskStart(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsDmsReplicationTaskRead(d, meta)
 }
 
@@ -217,9 +238,21 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	if hasChanges {
+		status, err := resourceAwsDmsReplicationTaskStatus(d, meta)
+		if err != nil {
+			return err
+		}
+
+		// A running task must be stopped before it can be modified.
+		if status == "running" {
+			if err := resourceAwsDmsReplicationTaskStop(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
 		log.Println("[DEBUG] DMS update replication task:", request)
 
-		_, err := conn.ModifyReplicationTask(request)
+		_, err = conn.ModifyReplicationTask(request)
 		if err != nil {
 			return err
 		}
@@ -228,7 +261,7 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 			Pending:    []string{"modifying"},
 			Target:     []string{"ready", "stopped", "failed"},
 			Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      30 * time.Second, // Wait 30 secs before starting
 		}
@@ -239,6 +272,32 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 			return err
 		}
 
+		if d.Get("start_replication_task").(bool) {
+			if err := resourceAwsDmsReplicationTaskStart(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		return resourceAwsDmsReplicationTaskRead(d, meta)
+	}
+
+	if d.HasChange("start_replication_task") {
+		if d.Get("start_replication_task").(bool) {
+			if err := resourceAwsDmsReplicationTaskStart(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			status, err := resourceAwsDmsReplicationTaskStatus(d, meta)
+			if err != nil {
+				return err
+			}
+			if status == "running" {
+				if err := resourceAwsDmsReplicationTaskStop(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return err
+				}
+			}
+		}
+
 		return resourceAwsDmsReplicationTaskRead(d, meta)
 	}
 
@@ -248,13 +307,25 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dmsconn
 
+	status, err := resourceAwsDmsReplicationTaskStatus(d, meta)
+	if err != nil {
+		return err
+	}
+
+	// A running task must be stopped before it can be deleted.
+	if status == "running" {
+		if err := resourceAwsDmsReplicationTaskStop(d, meta, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
 	request := &dms.DeleteReplicationTaskInput{
 		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
 	}
 
 	log.Printf("[DEBUG] DMS delete replication task: %#v", request)
 
-	_, err := conn.DeleteReplicationTask(request)
+	_, err = conn.DeleteReplicationTask(request)
 	if err != nil {
 		if dmserr, ok := err.(awserr.Error); ok && dmserr.Code() == "ResourceNotFoundFault" {
 			log.Printf("[DEBUG] DMS Replication Task %q Not Found", d.Id())
@@ -268,7 +339,7 @@ func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{
 		Pending:    []string{"deleting"},
 		Target:     []string{},
 		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
@@ -291,12 +362,107 @@ func resourceAwsDmsReplicationTaskSetState(d *schema.ResourceData, task *dms.Rep
 	d.Set("replication_task_id", task.ReplicationTaskIdentifier)
 	d.Set("replication_task_settings", task.ReplicationTaskSettings)
 	d.Set("source_endpoint_arn", task.SourceEndpointArn)
+	d.Set("status", task.Status)
 	d.Set("table_mappings", task.TableMappings)
 	d.Set("target_endpoint_arn", task.TargetEndpointArn)
 
 	return nil
 }
 
+// resourceAwsDmsReplicationTaskStart starts a task which has never been run,
+// or resumes a stopped task, and waits up to timeout for it to start.
+func resourceAwsDmsReplicationTaskStart(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	status, err := resourceAwsDmsReplicationTaskStatus(d, meta)
+	if err != nil {
+		return err
+	}
+	if status == "" {
+		return fmt.Errorf("DMS Replication Task %q not found", d.Id())
+	}
+	if status == "running" {
+		return nil
+	}
+
+	startType := dms.StartReplicationTaskTypeValueStartReplication
+	if status != "ready" {
+		startType = dms.StartReplicationTaskTypeValueResumeProcessing
+	}
+
+	request := &dms.StartReplicationTaskInput{
+		ReplicationTaskArn:       aws.String(d.Get("replication_task_arn").(string)),
+		StartReplicationTaskType: aws.String(startType),
+	}
+
+	log.Println("[DEBUG] DMS start replication task:", request)
+
+	if _, err := conn.StartReplicationTask(request); err != nil {
+		return fmt.Errorf("error starting DMS Replication Task (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"starting"},
+		// Full load tasks stop on their own once the load completes.
+		Target:     []string{"running", "stopped"},
+		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second, // Wait 30 secs before starting
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DMS Replication Task (%s) to start: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsDmsReplicationTaskStop stops a running task and waits up to
+// timeout for it to stop.
+func resourceAwsDmsReplicationTaskStop(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	conn := meta.(*AWSClient).dmsconn
+
+	request := &dms.StopReplicationTaskInput{
+		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
+	}
+
+	log.Println("[DEBUG] DMS stop replication task:", request)
+
+	if _, err := conn.StopReplicationTask(request); err != nil {
+		return fmt.Errorf("error stopping DMS Replication Task (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"running", "stopping"},
+		Target:     []string{"stopped"},
+		Refresh:    resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second, // Wait 30 secs before starting
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DMS Replication Task (%s) to stop: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsDmsReplicationTaskStatus returns the current status of the task,
+// or an empty string if it no longer exists.
+func resourceAwsDmsReplicationTaskStatus(d *schema.ResourceData, meta interface{}) (string, error) {
+	v, status, err := resourceAwsDmsReplicationTaskStateRefreshFunc(d, meta)()
+	if err != nil {
+		return "", err
+	}
+	if v == nil {
+		return "", nil
+	}
+
+	return status, nil
+}
+
 func resourceAwsDmsReplicationTaskStateRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
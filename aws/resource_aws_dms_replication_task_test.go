@@ -25,12 +25,14 @@ func TestAccAWSDmsReplicationTaskBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					checkDmsReplicationTaskExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "replication_task_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ready"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_replication_task"},
 			},
 			{
 				Config: dmsReplicationTaskConfigUpdate(randId),
@@ -42,6 +44,43 @@ func TestAccAWSDmsReplicationTaskBasic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDmsReplicationTask_StartReplicationTask(t *testing.T) {
+	resourceName := "aws_dms_replication_task.dms_replication_task"
+	randId := acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: dmsReplicationTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dmsReplicationTaskConfigStartReplicationTask(randId, false),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsReplicationTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_task", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ready"),
+				),
+			},
+			{
+				Config: dmsReplicationTaskConfigStartReplicationTask(randId, true),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsReplicationTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_task", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+				),
+			},
+			{
+				Config: dmsReplicationTaskConfigStartReplicationTask(randId, false),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsReplicationTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_task", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "stopped"),
+				),
+			},
+		},
+	})
+}
+
 func checkDmsReplicationTaskExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -269,3 +308,147 @@ resource "aws_dms_replication_task" "dms_replication_task" {
 }
 `, randId)
 }
+
+// dmsReplicationTaskConfigStartReplicationTask uses MySQL source and target
+// databases which DMS can connect to, so that a CDC task keeps running once
+// started.
+func dmsReplicationTaskConfigStartReplicationTask(randId string, start bool) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "dms_vpc" {
+	cidr_block = "10.1.0.0/16"
+	tags {
+		Name = "terraform-testacc-dms-replication-task-start"
+	}
+}
+
+resource "aws_subnet" "dms_subnet_1" {
+	cidr_block = "10.1.1.0/24"
+	availability_zone = "${data.aws_availability_zones.available.names[0]}"
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+	tags {
+		Name = "tf-acc-dms-replication-task-start-1"
+	}
+}
+
+resource "aws_subnet" "dms_subnet_2" {
+	cidr_block = "10.1.2.0/24"
+	availability_zone = "${data.aws_availability_zones.available.names[1]}"
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+	tags {
+		Name = "tf-acc-dms-replication-task-start-2"
+	}
+}
+
+resource "aws_security_group" "dms_security_group" {
+	name = "tf-test-dms-replication-task-%[1]s"
+	vpc_id = "${aws_vpc.dms_vpc.id}"
+
+	ingress {
+		from_port = 3306
+		to_port = 3306
+		protocol = "tcp"
+		cidr_blocks = ["${aws_vpc.dms_vpc.cidr_block}"]
+	}
+
+	egress {
+		from_port = 0
+		to_port = 0
+		protocol = "-1"
+		cidr_blocks = ["0.0.0.0/0"]
+	}
+}
+
+resource "aws_db_subnet_group" "dms_db_subnet_group" {
+	name = "tf-test-dms-replication-task-%[1]s"
+	subnet_ids = ["${aws_subnet.dms_subnet_1.id}", "${aws_subnet.dms_subnet_2.id}"]
+}
+
+# DMS reads changes from the binary log, which must use row based logging.
+resource "aws_db_parameter_group" "dms_db_parameter_group" {
+	name = "tf-test-dms-replication-task-%[1]s"
+	family = "mysql5.7"
+
+	parameter {
+		name = "binlog_format"
+		value = "ROW"
+	}
+}
+
+resource "aws_db_instance" "dms_db_source" {
+	identifier = "tf-test-dms-source-%[1]s"
+	allocated_storage = 10
+	engine = "mysql"
+	engine_version = "5.7"
+	instance_class = "db.t2.micro"
+	name = "tftest"
+	username = "tftest"
+	password = "tftestpassword"
+	backup_retention_period = 1
+	parameter_group_name = "${aws_db_parameter_group.dms_db_parameter_group.name}"
+	db_subnet_group_name = "${aws_db_subnet_group.dms_db_subnet_group.name}"
+	vpc_security_group_ids = ["${aws_security_group.dms_security_group.id}"]
+	skip_final_snapshot = true
+}
+
+resource "aws_db_instance" "dms_db_target" {
+	identifier = "tf-test-dms-target-%[1]s"
+	allocated_storage = 10
+	engine = "mysql"
+	engine_version = "5.7"
+	instance_class = "db.t2.micro"
+	name = "tftest"
+	username = "tftest"
+	password = "tftestpassword"
+	db_subnet_group_name = "${aws_db_subnet_group.dms_db_subnet_group.name}"
+	vpc_security_group_ids = ["${aws_security_group.dms_security_group.id}"]
+	skip_final_snapshot = true
+}
+
+resource "aws_dms_endpoint" "dms_endpoint_source" {
+	endpoint_id = "tf-test-dms-endpoint-source-%[1]s"
+	endpoint_type = "source"
+	engine_name = "mysql"
+	server_name = "${aws_db_instance.dms_db_source.address}"
+	port = 3306
+	username = "tftest"
+	password = "tftestpassword"
+}
+
+resource "aws_dms_endpoint" "dms_endpoint_target" {
+	endpoint_id = "tf-test-dms-endpoint-target-%[1]s"
+	endpoint_type = "target"
+	engine_name = "mysql"
+	server_name = "${aws_db_instance.dms_db_target.address}"
+	port = 3306
+	username = "tftest"
+	password = "tftestpassword"
+}
+
+resource "aws_dms_replication_subnet_group" "dms_replication_subnet_group" {
+	replication_subnet_group_id = "tf-test-dms-replication-subnet-group-%[1]s"
+	replication_subnet_group_description = "terraform test for replication subnet group"
+	subnet_ids = ["${aws_subnet.dms_subnet_1.id}", "${aws_subnet.dms_subnet_2.id}"]
+}
+
+resource "aws_dms_replication_instance" "dms_replication_instance" {
+	allocated_storage = 5
+	replication_instance_class = "dms.t2.micro"
+	replication_instance_id = "tf-test-dms-replication-instance-%[1]s"
+	publicly_accessible = false
+	replication_subnet_group_id = "${aws_dms_replication_subnet_group.dms_replication_subnet_group.replication_subnet_group_id}"
+	vpc_security_group_ids = ["${aws_security_group.dms_security_group.id}"]
+}
+
+resource "aws_dms_replication_task" "dms_replication_task" {
+	migration_type = "cdc"
+	replication_instance_arn = "${aws_dms_replication_instance.dms_replication_instance.replication_instance_arn}"
+	replication_task_id = "tf-test-dms-replication-task-%[1]s"
+	source_endpoint_arn = "${aws_dms_endpoint.dms_endpoint_source.endpoint_arn}"
+	start_replication_task = %[2]t
+	table_mappings = "{\"rules\":[{\"rule-type\":\"selection\",\"rule-id\":\"1\",\"rule-name\":\"1\",\"object-locator\":{\"schema-name\":\"tftest\",\"table-name\":\"%%\"},\"rule-action\":\"include\"}]}"
+	target_endpoint_arn = "${aws_dms_endpoint.dms_endpoint_target.endpoint_arn}"
+}
+`, randId, start)
+}
@@ -112,9 +112,13 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("bandwidth", connection.Bandwidth)
 	d.Set("location", connection.Location)
 
-	if err := getTagsDX(conn, d, arn); err != nil {
+	tags, err := directconnectListTags(conn, arn)
+	if err != nil {
 		return err
 	}
+	if err := d.Set("tags", tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -129,8 +133,11 @@ func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) err
 		AccountID: meta.(*AWSClient).accountid,
 		Resource:  fmt.Sprintf("dxcon/%s", d.Id()),
 	}.String()
-	if err := setTagsDX(conn, d, arn); err != nil {
-		return err
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := directconnectUpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating Direct Connect connection (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsDxConnectionRead(d, meta)
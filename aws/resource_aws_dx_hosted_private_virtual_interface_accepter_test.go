@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Hosted virtual interfaces are allocated by the owner of the Direct Connect
+// connection, so these tests create the interface using a second, "alternate"
+// provider configured from a named profile and accept it in the default account.
+func testAccDxHostedVirtualInterfaceAccepterEnv(t *testing.T) (string, string) {
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	key = "AWS_ALTERNATE_PROFILE"
+	profile := os.Getenv(key)
+	if profile == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	return connectionId, profile
+}
+
+func TestAccAwsDxHostedPrivateVirtualInterfaceAccepter_basic(t *testing.T) {
+	connectionId, profile := testAccDxHostedVirtualInterfaceAccepterEnv(t)
+	vifName := fmt.Sprintf("terraform-testacc-dxvif-%s", acctest.RandString(5))
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxHostedPrivateVirtualInterfaceAccepterConfig_basic(profile, connectionId, vifName, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxHostedVirtualInterfaceExists("aws_dx_hosted_private_virtual_interface_accepter.test"),
+					resource.TestCheckResourceAttrPair("aws_dx_hosted_private_virtual_interface_accepter.test", "virtual_interface_id", "aws_dx_hosted_private_virtual_interface.test", "id"),
+					resource.TestCheckResourceAttrPair("aws_dx_hosted_private_virtual_interface_accepter.test", "vpn_gateway_id", "aws_vpn_gateway.test", "id"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_private_virtual_interface_accepter.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_private_virtual_interface_accepter.test", "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDxHostedPrivateVirtualInterfaceAccepterConfig_basic(profile, cid, n string, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "alternate"
+  profile = "%s"
+}
+
+data "aws_caller_identity" "accepter" {}
+
+resource "aws_dx_hosted_private_virtual_interface" "test" {
+  provider = "aws.alternate"
+
+  connection_id    = "%s"
+  owner_account_id = "${data.aws_caller_identity.accepter.account_id}"
+
+  name           = "%s"
+  vlan           = %d
+  address_family = "ipv4"
+  bgp_asn        = %d
+}
+
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "%s"
+  }
+}
+
+resource "aws_dx_hosted_private_virtual_interface_accepter" "test" {
+  virtual_interface_id = "${aws_dx_hosted_private_virtual_interface.test.id}"
+  vpn_gateway_id       = "${aws_vpn_gateway.test.id}"
+
+  tags {
+    Environment = "test"
+  }
+}
+`, profile, cid, n, vlan, bgpAsn, n)
+}
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsDxHostedPrivateVirtualInterface_basic(t *testing.T) {
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	key = "DX_HOSTED_VIF_OWNER_ACCOUNT"
+	ownerAccountId := os.Getenv(key)
+	if ownerAccountId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	vifName := fmt.Sprintf("terraform-testacc-dxvif-%s", acctest.RandString(5))
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxHostedVirtualInterfaceDestroy("aws_dx_hosted_private_virtual_interface"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxHostedPrivateVirtualInterfaceConfig_basic(connectionId, ownerAccountId, vifName, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxHostedVirtualInterfaceExists("aws_dx_hosted_private_virtual_interface.foo"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_private_virtual_interface.foo", "name", vifName),
+					resource.TestCheckResourceAttr("aws_dx_hosted_private_virtual_interface.foo", "address_family", "ipv4"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_private_virtual_interface.foo", "owner_account_id", ownerAccountId),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      "aws_dx_hosted_private_virtual_interface.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxHostedVirtualInterfaceDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			input := &directconnect.DescribeVirtualInterfacesInput{
+				VirtualInterfaceId: aws.String(rs.Primary.ID),
+			}
+
+			resp, err := conn.DescribeVirtualInterfaces(input)
+			if err != nil {
+				return err
+			}
+			for _, v := range resp.VirtualInterfaces {
+				if *v.VirtualInterfaceId == rs.Primary.ID && !(*v.VirtualInterfaceState == directconnect.VirtualInterfaceStateDeleted) {
+					return fmt.Errorf("[DESTROY ERROR] Dx Hosted VIF (%s) not deleted", rs.Primary.ID)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckAwsDxHostedVirtualInterfaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDxHostedPrivateVirtualInterfaceConfig_basic(cid, ownerAcctId, n string, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_hosted_private_virtual_interface" "foo" {
+  connection_id    = "%s"
+  owner_account_id = "%s"
+
+  name           = "%s"
+  vlan           = %d
+  address_family = "ipv4"
+  bgp_asn        = %d
+}
+`, cid, ownerAcctId, n, vlan, bgpAsn)
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAwsDxHostedPublicVirtualInterfaceAccepter_basic(t *testing.T) {
+	connectionId, profile := testAccDxHostedVirtualInterfaceAccepterEnv(t)
+	vifName := fmt.Sprintf("terraform-testacc-dxvif-%s", acctest.RandString(5))
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxHostedPublicVirtualInterfaceAccepterConfig_basic(profile, connectionId, vifName, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxHostedVirtualInterfaceExists("aws_dx_hosted_public_virtual_interface_accepter.test"),
+					resource.TestCheckResourceAttrPair("aws_dx_hosted_public_virtual_interface_accepter.test", "virtual_interface_id", "aws_dx_hosted_public_virtual_interface.test", "id"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_public_virtual_interface_accepter.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_dx_hosted_public_virtual_interface_accepter.test", "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDxHostedPublicVirtualInterfaceAccepterConfig_basic(profile, cid, n string, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "alternate"
+  profile = "%s"
+}
+
+data "aws_caller_identity" "accepter" {}
+
+resource "aws_dx_hosted_public_virtual_interface" "test" {
+  provider = "aws.alternate"
+
+  connection_id    = "%s"
+  owner_account_id = "${data.aws_caller_identity.accepter.account_id}"
+
+  name           = "%s"
+  vlan           = %d
+  address_family = "ipv4"
+  bgp_asn        = %d
+
+  customer_address = "175.45.176.1/30"
+  amazon_address   = "175.45.176.2/30"
+
+  route_filter_prefixes = [
+    "210.52.109.0/24",
+    "175.45.176.0/22",
+  ]
+}
+
+resource "aws_dx_hosted_public_virtual_interface_accepter" "test" {
+  virtual_interface_id = "${aws_dx_hosted_public_virtual_interface.test.id}"
+
+  tags {
+    Environment = "test"
+  }
+}
+`, profile, cid, n, vlan, bgpAsn)
+}
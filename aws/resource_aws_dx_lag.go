@@ -136,9 +136,13 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("connections_bandwidth", lag.ConnectionsBandwidth)
 	d.Set("location", lag.Location)
 
-	if err := getTagsDX(conn, d, arn); err != nil {
+	tags, err := directconnectListTags(conn, arn)
+	if err != nil {
 		return err
 	}
+	if err := d.Set("tags", tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -170,9 +174,11 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 		AccountID: meta.(*AWSClient).accountid,
 		Resource:  fmt.Sprintf("dxlag/%s", d.Id()),
 	}.String()
-	if err := setTagsDX(conn, d, arn); err != nil {
-		return err
-	} else {
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := directconnectUpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating Direct Connect LAG (%s) tags: %s", d.Id(), err)
+		}
 		d.SetPartial("tags")
 	}
 
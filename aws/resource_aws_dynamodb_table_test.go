@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -35,20 +36,30 @@ func testSweepDynamoDbTables(region string) error {
 		"tf-autoscaled-table-",
 	}
 
+	var errors error
 	err = conn.ListTablesPages(&dynamodb.ListTablesInput{}, func(out *dynamodb.ListTablesOutput, lastPage bool) bool {
 		for _, tableName := range out.TableNames {
+			skip := true
 			for _, prefix := range prefixes {
-				if !strings.HasPrefix(*tableName, prefix) {
-					log.Printf("[INFO] Skipping DynamoDB Table: %s", *tableName)
-					continue
+				if strings.HasPrefix(*tableName, prefix) {
+					skip = false
+					break
 				}
 			}
-			log.Printf("[INFO] Deleting DynamoDB Table: %s", *tableName)
+			if skip {
+				log.Printf("[INFO] Skipping DynamoDB Table: %s", *tableName)
+				continue
+			}
 
+			if testSweepDryRun() {
+				log.Printf("[INFO] Would delete DynamoDB Table: %s", *tableName)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting DynamoDB Table: %s", *tableName)
 			err := deleteAwsDynamoDbTable(*tableName, conn)
 			if err != nil {
-				log.Printf("[ERROR] Failed to delete DynamoDB Table %s: %s", *tableName, err)
-				continue
+				errors = multierror.Append(errors, fmt.Errorf("Failed to delete DynamoDB Table %s: %s", *tableName, err))
 			}
 		}
 		return !lastPage
@@ -57,7 +68,7 @@ func testSweepDynamoDbTables(region string) error {
 		return fmt.Errorf("Error retrieving DynamoDB Tables: %s", err)
 	}
 
-	return nil
+	return errors
 }
 
 func TestDiffDynamoDbGSI(t *testing.T) {
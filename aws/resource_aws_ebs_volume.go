@@ -51,7 +51,7 @@ func resourceAwsEbsVolume() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 			"size": {
 				Type:     schema.TypeInt,
@@ -92,17 +92,11 @@ func resourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("registry_id", repository.RegistryId)
 	d.Set("name", repository.RepositoryName)
 
-	repositoryUrl := buildRepositoryUrl(repository, meta.(*AWSClient).region)
-	log.Printf("[INFO] Setting the repository url to be %s", repositoryUrl)
-	d.Set("repository_url", repositoryUrl)
+	d.Set("repository_url", repository.RepositoryUri)
 
 	return nil
 }
 
-func buildRepositoryUrl(repo *ecr.Repository, region string) string {
-	return fmt.Sprintf("%s.dkr.ecr.%s.amazonaws.com/%s", *repo.RegistryId, region, *repo.RepositoryName)
-}
-
 func resourceAwsEcrRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecrconn
 
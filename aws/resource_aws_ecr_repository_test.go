@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,6 +25,7 @@ func TestAccAWSEcrRepository_basic(t *testing.T) {
 				Config: testAccAWSEcrRepository(randString),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSEcrRepositoryExists("aws_ecr_repository.default"),
+					resource.TestMatchResourceAttr("aws_ecr_repository.default", "repository_url", regexp.MustCompile(`^\d+\.dkr\.ecr\.[a-z0-9-]+\.amazonaws\.com(\.cn)?/tf-acc-test-ecr-`+randString+`$`)),
 				),
 			},
 		},
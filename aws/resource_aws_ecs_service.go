@@ -27,6 +27,11 @@ func resourceAwsEcsService() *schema.Resource {
 			State: resourceAwsEcsServiceImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(iamPropagationTimeout),
+			Update: schema.DefaultTimeout(iamPropagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -97,9 +102,10 @@ func resourceAwsEcsService() *schema.Resource {
 						},
 
 						"target_group_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateLbTargetGroupArn,
 						},
 
 						"container_name": {
@@ -228,6 +234,10 @@ func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*s
 	return []*schema.ResourceData{d}, nil
 }
 
+// ecsServiceRolePropagationMessage is returned by CreateService and
+// UpdateService until the service role has propagated.
+const ecsServiceRolePropagationMessage = "Please verify that the ECS service role being passed has the proper permissions."
+
 func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
@@ -308,28 +318,17 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
 
 	// Retry due to AWS IAM & ECS eventual consistency
-	var out *ecs.CreateServiceOutput
-	var err error
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err = conn.CreateService(&input)
-
-		if err != nil {
-			if isAWSErr(err, ecs.ErrCodeClusterNotFoundException, "") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "Please verify that the ECS service role being passed has the proper permissions.") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	raw, err := retryOnIamPropagation(d.Timeout(schema.TimeoutCreate), []awsErrMatcher{
+		{ecs.ErrCodeClusterNotFoundException, ""},
+		{ecs.ErrCodeInvalidParameterException, ecsServiceRolePropagationMessage},
+	}, func() (interface{}, error) {
+		return conn.CreateService(&input)
 	})
 	if err != nil {
 		return fmt.Errorf("%s %q", err, d.Get("name").(string))
 	}
 
-	service := *out.Service
+	service := *raw.(*ecs.CreateServiceOutput).Service
 
 	log.Printf("[DEBUG] ECS service created: %s", *service.ServiceArn)
 	d.SetId(*service.ServiceArn)
@@ -555,22 +554,17 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Retry due to IAM eventual consistency
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err := conn.UpdateService(&input)
-		if err != nil {
-			if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "Please verify that the ECS service role being passed has the proper permissions.") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		log.Printf("[DEBUG] Updated ECS service %s", out.Service)
-		return nil
+	raw, err := retryOnIamPropagation(d.Timeout(schema.TimeoutUpdate), []awsErrMatcher{
+		{ecs.ErrCodeInvalidParameterException, ecsServiceRolePropagationMessage},
+	}, func() (interface{}, error) {
+		return conn.UpdateService(&input)
 	})
 	if err != nil {
 		return err
 	}
 
+	log.Printf("[DEBUG] Updated ECS service %s", raw.(*ecs.UpdateServiceOutput).Service)
+
 	return resourceAwsEcsServiceRead(d, meta)
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -29,6 +30,7 @@ func testSweepBeanstalkApplications(region string) error {
 	}
 	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn
 
+	// DescribeApplications returns every application, it isn't paginated.
 	resp, err := beanstalkconn.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
 	if err != nil {
 		return fmt.Errorf("Error retrieving beanstalk application: %s", err)
@@ -39,6 +41,7 @@ func testSweepBeanstalkApplications(region string) error {
 		return nil
 	}
 
+	var errors error
 	for _, bsa := range resp.Applications {
 		var testOptGroup bool
 		for _, testName := range []string{
@@ -56,6 +59,12 @@ func testSweepBeanstalkApplications(region string) error {
 			continue
 		}
 
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete beanstalk application (%s)", *bsa.ApplicationName)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting beanstalk application (%s)", *bsa.ApplicationName)
 		_, err := beanstalkconn.DeleteApplication(
 			&elasticbeanstalk.DeleteApplicationInput{
 				ApplicationName: bsa.ApplicationName,
@@ -64,14 +73,14 @@ func testSweepBeanstalkApplications(region string) error {
 			elasticbeanstalkerr, ok := err.(awserr.Error)
 			if ok && (elasticbeanstalkerr.Code() == "InvalidConfiguration.NotFound" || elasticbeanstalkerr.Code() == "ValidationError") {
 				log.Printf("[DEBUG] beanstalk application (%s) not found", *bsa.ApplicationName)
-				return nil
+				continue
 			}
 
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting beanstalk application (%s): %s", *bsa.ApplicationName, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSBeanstalkApp_basic(t *testing.T) {
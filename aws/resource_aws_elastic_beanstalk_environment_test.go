@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -33,37 +34,58 @@ func testSweepBeanstalkEnvironments(region string) error {
 	}
 	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn
 
-	resp, err := beanstalkconn.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{
+	var environments []*elasticbeanstalk.EnvironmentDescription
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
 		IncludeDeleted: aws.Bool(false),
-	})
+	}
+	for {
+		resp, err := beanstalkconn.DescribeEnvironments(input)
+		if err != nil {
+			return fmt.Errorf("Error retrieving beanstalk environment: %s", err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("Error retrieving beanstalk environment: %s", err)
+		for _, bse := range resp.Environments {
+			var testOptGroup bool
+			for _, testName := range []string{
+				"terraform-",
+				"tf-test-",
+				"tf_acc_",
+				"tf-acc-",
+			} {
+				if strings.HasPrefix(*bse.EnvironmentName, testName) {
+					testOptGroup = true
+				}
+			}
+
+			if !testOptGroup {
+				log.Printf("Skipping (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
+				continue
+			}
+			environments = append(environments, bse)
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
 	}
 
-	if len(resp.Environments) == 0 {
+	if len(environments) == 0 {
 		log.Print("[DEBUG] No aws beanstalk environments to sweep")
 		return nil
 	}
 
-	for _, bse := range resp.Environments {
-		var testOptGroup bool
-		for _, testName := range []string{
-			"terraform-",
-			"tf-test-",
-			"tf_acc_",
-			"tf-acc-",
-		} {
-			if strings.HasPrefix(*bse.EnvironmentName, testName) {
-				testOptGroup = true
-			}
-		}
-
-		if !testOptGroup {
-			log.Printf("Skipping (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
-			continue
+	if testSweepDryRun() {
+		for _, bse := range environments {
+			log.Printf("[INFO] Would terminate (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
 		}
+		return nil
+	}
 
+	// Start all terminations before waiting, as each one takes several minutes.
+	var errors error
+	var terminated []*elasticbeanstalk.EnvironmentDescription
+	for _, bse := range environments {
 		log.Printf("Trying to terminate (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
 
 		_, err := beanstalkconn.TerminateEnvironment(
@@ -76,12 +98,16 @@ func testSweepBeanstalkEnvironments(region string) error {
 			elasticbeanstalkerr, ok := err.(awserr.Error)
 			if ok && (elasticbeanstalkerr.Code() == "InvalidConfiguration.NotFound" || elasticbeanstalkerr.Code() == "ValidationError") {
 				log.Printf("[DEBUG] beanstalk environment (%s) not found", *bse.EnvironmentName)
-				return nil
+				continue
 			}
 
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error terminating Elastic Beanstalk Environment (%s): %s", *bse.EnvironmentId, err))
+			continue
 		}
+		terminated = append(terminated, bse)
+	}
 
+	for _, bse := range terminated {
 		waitForReadyTimeOut, _ := time.ParseDuration("5m")
 		pollInterval, _ := time.ParseDuration("10s")
 
@@ -99,14 +125,15 @@ func testSweepBeanstalkEnvironments(region string) error {
 
 		_, err = stateConf.WaitForState()
 		if err != nil {
-			return fmt.Errorf(
+			errors = multierror.Append(errors, fmt.Errorf(
 				"Error waiting for Elastic Beanstalk Environment (%s) to become terminated: %s",
-				*bse.EnvironmentId, err)
+				*bse.EnvironmentId, err))
+			continue
 		}
 		log.Printf("> Terminated (%s) (%s)", *bse.EnvironmentName, *bse.EnvironmentId)
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSBeanstalkEnv_basic(t *testing.T) {
@@ -80,8 +80,11 @@ func resourceAwsElastiCacheCommonSchema() map[string]*schema.Schema {
 			Type:     schema.TypeSet,
 			Optional: true,
 			ForceNew: true,
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Set:      schema.HashString,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateS3Arn,
+			},
+			Set: schema.HashString,
 		},
 		"snapshot_window": {
 			Type:         schema.TypeString,
@@ -119,8 +122,9 @@ func resourceAwsElastiCacheCommonSchema() map[string]*schema.Schema {
 			},
 		},
 		"notification_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateSnsTopicArn,
 		},
 
 		"snapshot_retention_limit": {
@@ -28,6 +28,12 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			State: resourceAwsElasticSearchDomainImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"access_policies": {
 				Type:             schema.TypeString,
@@ -212,8 +218,9 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 							}, false),
 						},
 						"cloudwatch_log_group_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCloudWatchLogGroupArn,
 						},
 						"enabled": {
 							Type:     schema.TypeBool,
@@ -386,31 +393,19 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 
 	log.Printf("[DEBUG] Creating ElasticSearch domain: %s", input)
 
-	// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform
-	var out *elasticsearch.CreateElasticsearchDomainOutput
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		var err error
-		out, err = conn.CreateElasticsearchDomain(&input)
-		if err != nil {
-			if isAWSErr(err, "InvalidTypeException", "Error setting policy") {
-				log.Printf("[DEBUG] Retrying creation of ElasticSearch domain %s", *input.DomainName)
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "ValidationException", "enable a service-linked role to give Amazon ES permissions") {
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "ValidationException", "Domain is still being deleted") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform.
+	// A domain with the same name which is still being deleted is retried too.
+	raw, err := retryOnIamPropagation(30*time.Second, []awsErrMatcher{
+		{"InvalidTypeException", "Error setting policy"},
+		{"ValidationException", "enable a service-linked role to give Amazon ES permissions"},
+		{"ValidationException", "Domain is still being deleted"},
+	}, func() (interface{}, error) {
+		return conn.CreateElasticsearchDomain(&input)
 	})
-
 	if err != nil {
 		return err
 	}
+	out := raw.(*elasticsearch.CreateElasticsearchDomainOutput)
 
 	d.SetId(*out.DomainStatus.ARN)
 
@@ -428,7 +423,7 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	d.SetPartial("tags")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
-	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
+	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -439,8 +434,8 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	return resourceAwsElasticSearchDomainRead(d, meta)
 }
 
-func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService, domainName, arn string) error {
-	return resource.Retry(60*time.Minute, func() *resource.RetryError {
+func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService, domainName, arn string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
 		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(domainName),
 		})
@@ -651,7 +646,7 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(d.Get("domain_name").(string)),
 		})
@@ -691,16 +686,16 @@ func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be deleted", domainName)
-	err = resourceAwsElasticSearchDomainDeleteWaiter(domainName, conn)
+	err = resourceAwsElasticSearchDomainDeleteWaiter(domainName, conn, d.Timeout(schema.TimeoutDelete))
 
 	return err
 }
 
-func resourceAwsElasticSearchDomainDeleteWaiter(domainName string, conn *elasticsearch.ElasticsearchService) error {
+func resourceAwsElasticSearchDomainDeleteWaiter(domainName string, conn *elasticsearch.ElasticsearchService, timeout time.Duration) error {
 	input := &elasticsearch.DescribeElasticsearchDomainInput{
 		DomainName: aws.String(domainName),
 	}
-	err := resource.Retry(90*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(timeout, func() *resource.RetryError {
 		out, err := conn.DescribeElasticsearchDomain(input)
 
 		if err != nil {
@@ -6,10 +6,12 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -34,10 +36,15 @@ func testSweepElasticSearchDomains(region string) error {
 		"tf-acc-test-",
 	}
 
+	// ListDomainNames returns every domain, it isn't paginated.
 	out, err := conn.ListDomainNames(&elasticsearch.ListDomainNamesInput{})
 	if err != nil {
 		return fmt.Errorf("Error retrieving Elasticsearch Domains: %s", err)
 	}
+
+	// Start all deletions before waiting, as each one takes several minutes.
+	var errors error
+	var deleted []string
 	for _, domain := range out.DomainNames {
 		skip := true
 		for _, prefix := range prefixes {
@@ -50,22 +57,31 @@ func testSweepElasticSearchDomains(region string) error {
 			log.Printf("[INFO] Skipping Elasticsearch Domain: %s", *domain.DomainName)
 			continue
 		}
-		log.Printf("[INFO] Deleting Elasticsearch Domain: %s", *domain.DomainName)
 
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete Elasticsearch Domain: %s", *domain.DomainName)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting Elasticsearch Domain: %s", *domain.DomainName)
 		_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
 			DomainName: domain.DomainName,
 		})
 		if err != nil {
-			log.Printf("[ERROR] Failed to delete Elasticsearch Domain %s: %s", *domain.DomainName, err)
+			errors = multierror.Append(errors, fmt.Errorf("Failed to delete Elasticsearch Domain %s: %s", *domain.DomainName, err))
 			continue
 		}
-		err = resourceAwsElasticSearchDomainDeleteWaiter(*domain.DomainName, conn)
+		deleted = append(deleted, *domain.DomainName)
+	}
+
+	for _, domainName := range deleted {
+		err = resourceAwsElasticSearchDomainDeleteWaiter(domainName, conn, 90*time.Minute)
 		if err != nil {
-			log.Printf("[ERROR] Failed to wait for deletion of Elasticsearch Domain %s: %s", *domain.DomainName, err)
+			errors = multierror.Append(errors, fmt.Errorf("Failed to wait for deletion of Elasticsearch Domain %s: %s", domainName, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSElasticSearchDomain_basic(t *testing.T) {
@@ -121,7 +137,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 						t.Fatal(err)
 					}
 
-					err = waitForElasticSearchDomainCreation(conn, name, name)
+					err = waitForElasticSearchDomainCreation(conn, name, name, 60*time.Minute)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -186,7 +186,7 @@ func resourceAwsElb() *schema.Resource {
 						"ssl_certificate_id": &schema.Schema{
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateArnService("acm", "iam"),
 						},
 					},
 				},
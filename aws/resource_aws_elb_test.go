@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -37,12 +38,8 @@ func testSweepELBs(region string) error {
 		"test-elb-",
 	}
 
-	return conn.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, func(out *elb.DescribeLoadBalancersOutput, isLast bool) bool {
-		if len(out.LoadBalancerDescriptions) == 0 {
-			log.Println("[INFO] No ELBs found for sweeping")
-			return false
-		}
-
+	var errors error
+	err = conn.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, func(out *elb.DescribeLoadBalancersOutput, isLast bool) bool {
 		for _, lb := range out.LoadBalancerDescriptions {
 			skip := true
 			for _, prefix := range prefixes {
@@ -55,13 +52,18 @@ func testSweepELBs(region string) error {
 				log.Printf("[INFO] Skipping ELB: %s", *lb.LoadBalancerName)
 				continue
 			}
-			log.Printf("[INFO] Deleting ELB: %s", *lb.LoadBalancerName)
 
+			if testSweepDryRun() {
+				log.Printf("[INFO] Would delete ELB: %s", *lb.LoadBalancerName)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting ELB: %s", *lb.LoadBalancerName)
 			_, err := conn.DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{
 				LoadBalancerName: lb.LoadBalancerName,
 			})
 			if err != nil {
-				log.Printf("[ERROR] Failed to delete ELB %s: %s", *lb.LoadBalancerName, err)
+				errors = multierror.Append(errors, fmt.Errorf("Failed to delete ELB %s: %s", *lb.LoadBalancerName, err))
 				continue
 			}
 			err = cleanupELBNetworkInterfaces(client.(*AWSClient).ec2conn, *lb.LoadBalancerName)
@@ -71,6 +73,11 @@ func testSweepELBs(region string) error {
 		}
 		return !isLast
 	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving ELBs: %s", err)
+	}
+
+	return errors
 }
 
 func TestAccAWSELB_basic(t *testing.T) {
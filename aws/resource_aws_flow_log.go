@@ -21,9 +21,10 @@ func resourceAwsFlowLog() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"iam_role_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"log_group_name": &schema.Schema{
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -30,6 +31,7 @@ func testSweepGameliftAliases(region string) error {
 	}
 	conn := client.(*AWSClient).gameliftconn
 
+	var errors error
 	err = listGameliftAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
 		if len(resp.Aliases) == 0 {
 			log.Print("[DEBUG] No Gamelift Aliases to sweep")
@@ -43,13 +45,18 @@ func testSweepGameliftAliases(region string) error {
 				continue
 			}
 
+			if testSweepDryRun() {
+				log.Printf("[INFO] Would delete Gamelift Alias %q", *alias.AliasId)
+				continue
+			}
+
 			log.Printf("[INFO] Deleting Gamelift Alias %q", *alias.AliasId)
 			_, err := conn.DeleteAlias(&gamelift.DeleteAliasInput{
 				AliasId: alias.AliasId,
 			})
 			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Alias (%s): %s",
-					*alias.AliasId, err)
+				errors = multierror.Append(errors, fmt.Errorf("Error deleting Gamelift Alias (%s): %s",
+					*alias.AliasId, err))
 			}
 		}
 		return nil
@@ -58,7 +65,7 @@ func testSweepGameliftAliases(region string) error {
 		return fmt.Errorf("Error listing Gamelift Aliases: %s", err)
 	}
 
-	return nil
+	return errors
 }
 
 func listGameliftAliases(input *gamelift.ListAliasesInput, conn *gamelift.GameLift, f func(*gamelift.ListAliasesOutput) error) error {
@@ -54,7 +54,7 @@ func resourceAwsGameliftBuild() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -29,34 +30,50 @@ func testSweepGameliftBuilds(region string) error {
 	}
 	conn := client.(*AWSClient).gameliftconn
 
-	resp, err := conn.ListBuilds(&gamelift.ListBuildsInput{})
-	if err != nil {
-		return fmt.Errorf("Error listing Gamelift Builds: %s", err)
+	var builds []*gamelift.Build
+	input := &gamelift.ListBuildsInput{}
+	for {
+		resp, err := conn.ListBuilds(input)
+		if err != nil {
+			return fmt.Errorf("Error listing Gamelift Builds: %s", err)
+		}
+		builds = append(builds, resp.Builds...)
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
 	}
 
-	if len(resp.Builds) == 0 {
+	if len(builds) == 0 {
 		log.Print("[DEBUG] No Gamelift Builds to sweep")
 		return nil
 	}
 
-	log.Printf("[INFO] Found %d Gamelift Builds", len(resp.Builds))
+	log.Printf("[INFO] Found %d Gamelift Builds", len(builds))
 
-	for _, build := range resp.Builds {
+	var errors error
+	for _, build := range builds {
 		if !strings.HasPrefix(*build.Name, testAccGameliftBuildPrefix) {
 			continue
 		}
 
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete Gamelift Build %q", *build.BuildId)
+			continue
+		}
+
 		log.Printf("[INFO] Deleting Gamelift Build %q", *build.BuildId)
 		_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
 			BuildId: build.BuildId,
 		})
 		if err != nil {
-			return fmt.Errorf("Error deleting Gamelift Build (%s): %s",
-				*build.BuildId, err)
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting Gamelift Build (%s): %s",
+				*build.BuildId, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSGameliftBuild_basic(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -34,9 +35,9 @@ func testSweepGameliftFleets(region string) error {
 	}
 	conn := client.(*AWSClient).gameliftconn
 
-	return testAccGameliftListFleets(conn, nil, func(fleetIds []*string) error {
+	var fleetIDs []string
+	err = testAccGameliftListFleets(conn, nil, func(fleetIds []*string) error {
 		if len(fleetIds) == 0 {
-			log.Print("[DEBUG] No Gamelift Fleets to sweep")
 			return nil
 		}
 
@@ -47,40 +48,66 @@ func testSweepGameliftFleets(region string) error {
 			return fmt.Errorf("Error describing Gamelift Fleet attributes: %s", err)
 		}
 
-		log.Printf("[INFO] Found %d Gamelift Fleets", len(out.FleetAttributes))
-
 		for _, attr := range out.FleetAttributes {
-			if !strings.HasPrefix(*attr.Name, testAccGameliftFleetPrefix) {
-				continue
+			if strings.HasPrefix(*attr.Name, testAccGameliftFleetPrefix) {
+				fleetIDs = append(fleetIDs, *attr.FleetId)
 			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-			log.Printf("[INFO] Deleting Gamelift Fleet %q", *attr.FleetId)
-			err := resource.Retry(60*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
-					FleetId: attr.FleetId,
-				})
-				if err != nil {
-					msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", *attr.FleetId)
-					if isAWSErr(err, gamelift.ErrCodeInvalidRequestException, msg) {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+	if len(fleetIDs) == 0 {
+		log.Print("[DEBUG] No Gamelift Fleets to sweep")
+		return nil
+	}
+
+	log.Printf("[INFO] Found %d Gamelift Fleets", len(fleetIDs))
+
+	if testSweepDryRun() {
+		for _, fleetID := range fleetIDs {
+			log.Printf("[INFO] Would delete Gamelift Fleet %q", fleetID)
+		}
+		return nil
+	}
+
+	// Start all deletions before waiting, as each one takes several minutes.
+	var errors error
+	var deleted []string
+	for _, fleetID := range fleetIDs {
+		log.Printf("[INFO] Deleting Gamelift Fleet %q", fleetID)
+		err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+			_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
+				FleetId: aws.String(fleetID),
 			})
 			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Fleet (%s): %s",
-					*attr.FleetId, err)
+				msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", fleetID)
+				if isAWSErr(err, gamelift.ErrCodeInvalidRequestException, msg) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
 			}
+			return nil
+		})
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting Gamelift Fleet (%s): %s",
+				fleetID, err))
+			continue
+		}
+		deleted = append(deleted, fleetID)
+	}
 
-			err = waitForGameliftFleetToBeDeleted(conn, *attr.FleetId, 5*time.Minute)
-			if err != nil {
-				return fmt.Errorf("Error waiting for Gamelift Fleet (%s) to be deleted: %s",
-					*attr.FleetId, err)
-			}
+	for _, fleetID := range deleted {
+		err := waitForGameliftFleetToBeDeleted(conn, fleetID, 5*time.Minute)
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error waiting for Gamelift Fleet (%s) to be deleted: %s",
+				fleetID, err))
 		}
-		return nil
-	})
+	}
+
+	return errors
 }
 
 func testAccGameliftListFleets(conn *gamelift.GameLift, nextToken *string, f func([]*string) error) error {
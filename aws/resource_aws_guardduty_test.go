@@ -7,8 +7,10 @@ import (
 func TestAccAWSGuardDuty(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Detector": {
-			"basic":  testAccAwsGuardDutyDetector_basic,
-			"import": testAccAwsGuardDutyDetector_import,
+			"basic":            testAccAwsGuardDutyDetector_basic,
+			"import":           testAccAwsGuardDutyDetector_import,
+			"datasource_basic": testAccAwsGuardDutyDetectorDataSource_basic,
+			"datasource_id":    testAccAwsGuardDutyDetectorDataSource_Id,
 		},
 		"IPSet": {
 			"basic":  testAccAwsGuardDutyIpset_basic,
@@ -24,8 +24,9 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIAMPolicyJson,
 			},
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
@@ -47,9 +47,10 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"policy_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamPolicyArn,
 			},
 		},
 	}
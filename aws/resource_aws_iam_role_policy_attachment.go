@@ -24,9 +24,10 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 				ForceNew: true,
 			},
 			"policy_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamPolicyArn,
 			},
 		},
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -33,6 +34,7 @@ func testSweepIamServerCertificates(region string) error {
 		"terraform-test-cert-",
 	}
 
+	var errors error
 	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
 			hasPrefix := false
@@ -44,15 +46,19 @@ func testSweepIamServerCertificates(region string) error {
 			if !hasPrefix {
 				continue
 			}
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
 
+			if testSweepDryRun() {
+				log.Printf("[INFO] Would delete IAM Server Certificate: %s", *sc.ServerCertificateName)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
 			_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
 				ServerCertificateName: sc.ServerCertificateName,
 			})
 			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					*sc.ServerCertificateName, err)
-				continue
+				errors = multierror.Append(errors, fmt.Errorf("Failed to delete IAM Server Certificate %s: %s",
+					*sc.ServerCertificateName, err))
 			}
 		}
 		return !lastPage
@@ -61,7 +67,7 @@ func testSweepIamServerCertificates(region string) error {
 		return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSIAMServerCertificate_basic(t *testing.T) {
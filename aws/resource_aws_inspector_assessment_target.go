@@ -29,8 +29,9 @@ func resourceAWSInspectorAssessmentTarget() *schema.Resource {
 				Computed: true,
 			},
 			"resource_group_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArnServiceResource("inspector", "resourcegroup/"),
 			},
 		},
 	}
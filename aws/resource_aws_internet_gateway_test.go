@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -26,6 +27,8 @@ func testSweepInternetGateways(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	// DescribeInternetGateways returns every matching gateway, it isn't
+	// paginated.
 	req := &ec2.DescribeInternetGatewaysInput{
 		Filters: []*ec2.Filter{
 			{
@@ -46,18 +49,46 @@ func testSweepInternetGateways(region string) error {
 		return nil
 	}
 
+	var errors error
 	for _, internetGateway := range resp.InternetGateways {
+		id := *internetGateway.InternetGatewayId
+
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete Internet Gateway (%s)", id)
+			continue
+		}
+
+		// An attached gateway can't be deleted.
+		detached := true
+		for _, attachment := range internetGateway.Attachments {
+			log.Printf("[INFO] Detaching Internet Gateway (%s) from VPC (%s)", id, *attachment.VpcId)
+			_, err := conn.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+				InternetGatewayId: internetGateway.InternetGatewayId,
+				VpcId:             attachment.VpcId,
+			})
+			if err != nil && !isAWSErr(err, "Gateway.NotAttached", "") {
+				errors = multierror.Append(errors, fmt.Errorf(
+					"Error detaching Internet Gateway (%s) from VPC (%s): %s",
+					id, *attachment.VpcId, err))
+				detached = false
+			}
+		}
+		if !detached {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting Internet Gateway (%s)", id)
 		_, err := conn.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
 			InternetGatewayId: internetGateway.InternetGatewayId,
 		})
 		if err != nil {
-			return fmt.Errorf(
+			errors = multierror.Append(errors, fmt.Errorf(
 				"Error deleting Internet Gateway (%s): %s",
-				*internetGateway.InternetGatewayId, err)
+				id, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSInternetGateway_basic(t *testing.T) {
@@ -53,7 +53,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 						"state_reason": {
 							Type:     schema.TypeString,
@@ -96,7 +96,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -137,7 +137,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 						"table_name": {
 							Type:     schema.TypeString,
@@ -167,7 +167,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -188,7 +188,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -205,7 +205,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 						"stream_name": {
 							Type:     schema.TypeString,
@@ -222,7 +222,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"function_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 					},
 				},
@@ -235,7 +235,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 						"topic": {
 							Type:     schema.TypeString,
@@ -260,7 +260,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -278,12 +278,12 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"target_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateSnsTopicArn,
 						},
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -300,7 +300,7 @@ func resourceAwsIotTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 						"use_base64": {
 							Type:     schema.TypeBool,
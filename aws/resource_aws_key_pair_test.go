@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -29,6 +30,7 @@ func testSweepKeyPairs(region string) error {
 
 	log.Printf("Destroying the tmp keys in (%s)", client.(*AWSClient).region)
 
+	// DescribeKeyPairs returns every matching key pair, it isn't paginated.
 	resp, err := ec2conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -41,17 +43,23 @@ func testSweepKeyPairs(region string) error {
 		return fmt.Errorf("Error describing key pairs in Sweeper: %s", err)
 	}
 
+	var errors error
 	keyPairs := resp.KeyPairs
 	for _, d := range keyPairs {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete key pair %s", *d.KeyName)
+			continue
+		}
+
 		_, err := ec2conn.DeleteKeyPair(&ec2.DeleteKeyPairInput{
 			KeyName: d.KeyName,
 		})
 
 		if err != nil {
-			return fmt.Errorf("Error deleting key pairs in Sweeper: %s", err)
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting key pair %s in Sweeper: %s", *d.KeyName, err))
 		}
 	}
-	return nil
+	return errors
 }
 
 func TestAccAWSKeyPair_basic(t *testing.T) {
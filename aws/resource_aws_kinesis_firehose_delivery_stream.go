@@ -51,8 +51,9 @@ func s3ConfigurationSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"bucket_arn": {
-					Type:     schema.TypeString,
-					Required: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArnServiceResource("s3"),
 				},
 
 				"buffer_size": {
@@ -76,12 +77,13 @@ func s3ConfigurationSchema() *schema.Schema {
 				"kms_key_arn": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: validateArn,
+					ValidateFunc: validateKmsKeyArn,
 				},
 
 				"role_arn": {
-					Type:     schema.TypeString,
-					Required: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateIamRoleArn,
 				},
 
 				"prefix": {
@@ -417,13 +419,13 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						"kinesis_stream_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateArnServiceResource("kinesis", "stream/"),
 						},
 
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -457,8 +459,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bucket_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArnServiceResource("s3"),
 						},
 
 						"buffer_size": {
@@ -482,12 +485,13 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						"kms_key_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateKmsKeyArn,
 						},
 
 						"role_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 
 						"prefix": {
@@ -525,8 +529,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						},
 
 						"role_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 
 						"s3_backup_mode": {
@@ -614,8 +619,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						},
 
 						"domain_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArnServiceResource("es", "domain/"),
 						},
 
 						"index_name": {
@@ -652,8 +658,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						},
 
 						"role_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 
 						"s3_backup_mode": {
@@ -1317,28 +1324,13 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	var lastError error
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.CreateDeliveryStream(createInput)
-		if err != nil {
-			log.Printf("[DEBUG] Error creating Firehose Delivery Stream: %s", err)
-			lastError = err
-
-			// Retry for IAM eventual consistency
-			if isAWSErr(err, firehose.ErrCodeInvalidArgumentException, "is not authorized to perform") {
-				return resource.RetryableError(err)
-			}
-			// IAM roles can take ~10 seconds to propagate in AWS:
-			// http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html#launch-instance-with-role-console
-			if isAWSErr(err, firehose.ErrCodeInvalidArgumentException, "Firehose is unable to assume role") {
-				log.Printf("[DEBUG] Firehose could not assume role referenced, retrying...")
-				return resource.RetryableError(err)
-			}
-			// Not retryable
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	// IAM roles can take ~10 seconds to propagate in AWS:
+	// http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html#launch-instance-with-role-console
+	_, err := retryOnIamPropagation(1*time.Minute, []awsErrMatcher{
+		{firehose.ErrCodeInvalidArgumentException, "is not authorized to perform"},
+		{firehose.ErrCodeInvalidArgumentException, "Firehose is unable to assume role"},
+	}, func() (interface{}, error) {
+		return conn.CreateDeliveryStream(createInput)
 	})
 	if err != nil {
 		return fmt.Errorf("error creating Kinesis Firehose Delivery Stream: %s", err)
@@ -41,7 +41,7 @@ func resourceAwsKmsGrant() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnService("iam", "sts"),
 			},
 			"operations": {
 				Type: schema.TypeSet,
@@ -91,7 +91,7 @@ func resourceAwsKmsGrant() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateArnService("iam", "sts"),
 			},
 			"grant_creation_tokens": {
 				Type:     schema.TypeSet,
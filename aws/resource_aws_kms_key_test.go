@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -28,14 +29,15 @@ func testSweepKmsKeys(region string) error {
 	}
 	conn := client.(*AWSClient).kmsconn
 
+	var errors error
 	err = conn.ListKeysPages(&kms.ListKeysInput{Limit: aws.Int64(int64(1000))}, func(out *kms.ListKeysOutput, lastPage bool) bool {
 		for _, k := range out.Keys {
 			kOut, err := conn.DescribeKey(&kms.DescribeKeyInput{
 				KeyId: k.KeyId,
 			})
 			if err != nil {
-				log.Printf("Error: Failed to describe key %q: %s", *k.KeyId, err)
-				return false
+				errors = multierror.Append(errors, fmt.Errorf("Failed to describe key %q: %s", *k.KeyId, err))
+				continue
 			}
 			if *kOut.KeyMetadata.KeyManager == kms.KeyManagerTypeAws {
 				// Skip (default) keys which are managed by AWS
@@ -50,21 +52,26 @@ func testSweepKmsKeys(region string) error {
 				KeyId: k.KeyId,
 			})
 			if err != nil {
-				log.Printf("Error: Failed to get tags for key %q: %s", *k.KeyId, err)
-				return false
+				errors = multierror.Append(errors, fmt.Errorf("Failed to get tags for key %q: %s", *k.KeyId, err))
+				continue
 			}
 			if !kmsTagHasPrefix(tOut.Tags, "Name", "tf-acc-test-kms-key-") {
 				// Skip keys which don't have designated tag
 				continue
 			}
 
+			if testSweepDryRun() {
+				log.Printf("[INFO] Would schedule key %q for deletion", *k.KeyId)
+				continue
+			}
+
+			log.Printf("[INFO] Scheduling key %q for deletion", *k.KeyId)
 			_, err = conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
 				KeyId:               k.KeyId,
 				PendingWindowInDays: aws.Int64(int64(7)),
 			})
 			if err != nil {
-				log.Printf("Error: Failed to schedule key %q for deletion: %s", *k.KeyId, err)
-				return false
+				errors = multierror.Append(errors, fmt.Errorf("Failed to schedule key %q for deletion: %s", *k.KeyId, err))
 			}
 		}
 		return !lastPage
@@ -73,7 +80,7 @@ func testSweepKmsKeys(region string) error {
 		return fmt.Errorf("Error describing KMS keys: %s", err)
 	}
 
-	return nil
+	return errors
 }
 
 func kmsTagHasPrefix(tags []*kms.Tag, key, prefix string) bool {
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -25,9 +24,10 @@ func resourceAwsLambdaEventSourceMapping() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"event_source_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnService("dynamodb", "kinesis", "sqs"),
 			},
 			"function_name": {
 				Type:     schema.TypeString,
@@ -78,6 +78,13 @@ func resourceAwsLambdaEventSourceMapping() *schema.Resource {
 
 // resourceAwsLambdaEventSourceMappingCreate maps to:
 // CreateEventSourceMapping in the API / SDK
+// lambdaEventSourceMappingIamPropagationErrors are returned by
+// CreateEventSourceMapping and UpdateEventSourceMapping until the function's
+// execution role has propagated.
+var lambdaEventSourceMappingIamPropagationErrors = []awsErrMatcher{
+	{"InvalidParameterValueException", ""},
+}
+
 func resourceAwsLambdaEventSourceMappingCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lambdaconn
 
@@ -101,25 +108,16 @@ func resourceAwsLambdaEventSourceMappingCreate(d *schema.ResourceData, meta inte
 	//
 	// The role may exist, but the permissions may not have propagated, so we
 	// retry
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		eventSourceMappingConfiguration, err := conn.CreateEventSourceMapping(params)
-		if err != nil {
-			if awserr, ok := err.(awserr.Error); ok {
-				if awserr.Code() == "InvalidParameterValueException" {
-					return resource.RetryableError(awserr)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		// No error
-		d.Set("uuid", eventSourceMappingConfiguration.UUID)
-		d.SetId(*eventSourceMappingConfiguration.UUID)
-		return nil
+	raw, err := retryOnIamPropagation(5*time.Minute, lambdaEventSourceMappingIamPropagationErrors, func() (interface{}, error) {
+		return conn.CreateEventSourceMapping(params)
 	})
-
 	if err != nil {
 		return fmt.Errorf("Error creating Lambda event source mapping: %s", err)
 	}
+	eventSourceMappingConfiguration := raw.(*lambda.EventSourceMappingConfiguration)
+
+	d.Set("uuid", eventSourceMappingConfiguration.UUID)
+	d.SetId(*eventSourceMappingConfiguration.UUID)
 
 	return resourceAwsLambdaEventSourceMappingRead(d, meta)
 }
@@ -194,17 +192,8 @@ func resourceAwsLambdaEventSourceMappingUpdate(d *schema.ResourceData, meta inte
 		Enabled:      aws.Bool(d.Get("enabled").(bool)),
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, err := conn.UpdateEventSourceMapping(params)
-		if err != nil {
-			if awserr, ok := err.(awserr.Error); ok {
-				if awserr.Code() == "InvalidParameterValueException" {
-					return resource.RetryableError(awserr)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := retryOnIamPropagation(5*time.Minute, lambdaEventSourceMappingIamPropagationErrors, func() (interface{}, error) {
+		return conn.UpdateEventSourceMapping(params)
 	})
 
 	if err != nil {
@@ -34,6 +34,11 @@ func resourceAwsLambdaFunction() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(iamPropagationTimeout),
+			Update: schema.DefaultTimeout(iamPropagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"filename": {
 				Type:          schema.TypeString,
@@ -69,7 +74,7 @@ func resourceAwsLambdaFunction() *schema.Resource {
 						"target_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateArnService("sns", "sqs"),
 						},
 					},
 				},
@@ -207,7 +212,7 @@ func resourceAwsLambdaFunction() *schema.Resource {
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			"tags": tagsSchema(),
@@ -229,6 +234,15 @@ func updateComputedAttributesOnPublish(d *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// lambdaFunctionIamPropagationErrors are returned by CreateFunction and
+// UpdateFunctionConfiguration until the execution role has propagated. EC2
+// throttling is also retried here, and further below if it persists.
+var lambdaFunctionIamPropagationErrors = []awsErrMatcher{
+	{"InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda"},
+	{"InvalidParameterValueException", "The provided execution role does not have permissions"},
+	{"InvalidParameterValueException", "Your request has been throttled by EC2"},
+}
+
 // resourceAwsLambdaFunction maps to:
 // CreateFunction in the API / SDK
 func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -362,27 +376,8 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// IAM changes can take 1 minute to propagate in AWS
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.CreateFunction(params)
-		if err != nil {
-			log.Printf("[DEBUG] Error creating Lambda Function: %s", err)
-
-			if isAWSErr(err, "InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda") {
-				log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "InvalidParameterValueException", "The provided execution role does not have permissions") {
-				log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, "InvalidParameterValueException", "Your request has been throttled by EC2") {
-				log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := retryOnIamPropagation(d.Timeout(schema.TimeoutCreate), lambdaFunctionIamPropagationErrors, func() (interface{}, error) {
+		return conn.CreateFunction(params)
 	})
 	if err != nil {
 		if !isAWSErr(err, "InvalidParameterValueException", "Your request has been throttled by EC2") {
@@ -706,26 +701,8 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Send Update Lambda Function Configuration request: %#v", configReq)
 
 		// IAM changes can take 1 minute to propagate in AWS
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.UpdateFunctionConfiguration(configReq)
-			if err != nil {
-				log.Printf("[DEBUG] Received error modifying Lambda Function Configuration %s: %s", d.Id(), err)
-
-				if isAWSErr(err, "InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda") {
-					log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-					return resource.RetryableError(err)
-				}
-				if isAWSErr(err, "InvalidParameterValueException", "The provided execution role does not have permissions") {
-					log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-					return resource.RetryableError(err)
-				}
-				if isAWSErr(err, "InvalidParameterValueException", "Your request has been throttled by EC2, please make sure you have enough API rate limit.") {
-					log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		_, err := retryOnIamPropagation(d.Timeout(schema.TimeoutUpdate), lambdaFunctionIamPropagationErrors, func() (interface{}, error) {
+			return conn.UpdateFunctionConfiguration(configReq)
 		})
 		if err != nil {
 			if !isAWSErr(err, "InvalidParameterValueException", "Your request has been throttled by EC2, please make sure you have enough API rate limit.") {
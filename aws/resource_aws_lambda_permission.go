@@ -98,28 +98,16 @@ func resourceAwsLambdaPermissionCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Adding new Lambda permission: %s", input)
-	var out *lambda.AddPermissionOutput
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		out, err = conn.AddPermission(&input)
-
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				// IAM is eventually consistent :/
-				if awsErr.Code() == "ResourceConflictException" {
-					return resource.RetryableError(
-						fmt.Errorf("[WARN] Error adding new Lambda Permission for %s, retrying: %s",
-							*input.FunctionName, err))
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	// IAM is eventually consistent :/
+	raw, err := retryOnIamPropagation(1*time.Minute, []awsErrMatcher{
+		{"ResourceConflictException", ""},
+	}, func() (interface{}, error) {
+		return conn.AddPermission(&input)
 	})
-
 	if err != nil {
-		return err
+		return fmt.Errorf("Error adding new Lambda Permission for %s: %s", *input.FunctionName, err)
 	}
+	out := raw.(*lambda.AddPermissionOutput)
 
 	if out != nil && out.Statement != nil {
 		log.Printf("[DEBUG] Created new Lambda permission: %s", *out.Statement)
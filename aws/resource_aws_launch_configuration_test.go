@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -31,33 +32,45 @@ func testSweepLaunchConfigurations(region string) error {
 	}
 	autoscalingconn := client.(*AWSClient).autoscalingconn
 
-	resp, err := autoscalingconn.DescribeLaunchConfigurations(&autoscaling.DescribeLaunchConfigurationsInput{})
-	if err != nil {
-		return fmt.Errorf("Error retrieving launch configuration: %s", err)
-	}
-
-	if len(resp.LaunchConfigurations) == 0 {
-		log.Print("[DEBUG] No aws launch configurations to sweep")
-		return nil
-	}
-
 	prefixes := []string{
 		"foobar",
 		"terraform-",
 		"tf-acc-",
 		"TestAcc",
 	}
-	for _, lc := range resp.LaunchConfigurations {
-		name := *lc.LaunchConfigurationName
-		skip := true
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(name, prefix) {
-				skip = false
+
+	var names []string
+	err = autoscalingconn.DescribeLaunchConfigurationsPages(&autoscaling.DescribeLaunchConfigurationsInput{}, func(page *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+		for _, lc := range page.LaunchConfigurations {
+			name := *lc.LaunchConfigurationName
+			skip := true
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(name, prefix) {
+					skip = false
+				}
+			}
+
+			if skip {
+				log.Printf("[INFO] Skipping Launch Configuration: %s", name)
+				continue
 			}
+			names = append(names, name)
 		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving launch configuration: %s", err)
+	}
+
+	if len(names) == 0 {
+		log.Print("[DEBUG] No aws launch configurations to sweep")
+		return nil
+	}
 
-		if skip {
-			log.Printf("[INFO] Skipping Launch Configuration: %s", name)
+	var errors error
+	for _, name := range names {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete Launch Configuration: %s", name)
 			continue
 		}
 
@@ -68,13 +81,13 @@ func testSweepLaunchConfigurations(region string) error {
 			})
 		if err != nil {
 			if isAWSErr(err, "InvalidConfiguration.NotFound", "") || isAWSErr(err, "ValidationError", "") {
-				return nil
+				continue
 			}
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting Launch Configuration %s: %s", name, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSLaunchConfiguration_basic(t *testing.T) {
@@ -32,9 +32,10 @@ func resourceAwsLbListener() *schema.Resource {
 			},
 
 			"load_balancer_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnServiceResource("elasticloadbalancing", "loadbalancer/"),
 			},
 
 			"port": {
@@ -60,8 +61,9 @@ func resourceAwsLbListener() *schema.Resource {
 			},
 
 			"certificate_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnService("acm", "iam"),
 			},
 
 			"default_action": {
@@ -70,8 +72,9 @@ func resourceAwsLbListener() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_group_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLbTargetGroupArn,
 						},
 						"type": {
 							Type:         schema.TypeString,
@@ -33,9 +33,10 @@ func resourceAwsLbbListenerRule() *schema.Resource {
 				Computed: true,
 			},
 			"listener_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLbListenerArn,
 			},
 			"priority": {
 				Type:         schema.TypeInt,
@@ -50,8 +51,9 @@ func resourceAwsLbbListenerRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_group_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLbTargetGroupArn,
 						},
 						"type": {
 							Type:         schema.TypeString,
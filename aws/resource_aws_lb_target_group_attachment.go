@@ -20,9 +20,10 @@ func resourceAwsLbTargetGroupAttachment() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"target_group_arn": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validateLbTargetGroupArn,
 			},
 
 			"target_id": {
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailDisk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDiskCreate,
+		Read:   resourceAwsLightsailDiskRead,
+		Delete: resourceAwsLightsailDiskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size_in_gb": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_attached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"attached_to": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDiskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateDiskInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+		DiskName:         aws.String(name),
+		SizeInGb:         aws.Int64(int64(d.Get("size_in_gb").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Disk: %s", input)
+	resp, err := conn.CreateDisk(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Disk (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Disk (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailDiskRead(d, meta)
+}
+
+func resourceAwsLightsailDiskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Disk (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Disk (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.Disk == nil {
+		log.Printf("[WARN] Lightsail Disk (%s) not found, nil response from server, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	disk := resp.Disk
+
+	d.Set("name", disk.Name)
+	if disk.Location != nil {
+		d.Set("availability_zone", disk.Location.AvailabilityZone)
+	}
+	d.Set("size_in_gb", disk.SizeInGb)
+
+	d.Set("arn", disk.Arn)
+	d.Set("created_at", aws.TimeValue(disk.CreatedAt).Format(time.RFC3339))
+	d.Set("iops", disk.Iops)
+	d.Set("is_attached", disk.IsAttached)
+	d.Set("attached_to", disk.AttachedTo)
+	d.Set("path", disk.Path)
+	d.Set("support_code", disk.SupportCode)
+
+	return nil
+}
+
+func resourceAwsLightsailDiskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Deleting Lightsail Disk: %s", d.Id())
+	resp, err := conn.DeleteDisk(&lightsail.DeleteDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Disk (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Disk (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDiskAttachmentCreate,
+		Read:   resourceAwsLightsailDiskAttachmentRead,
+		Delete: resourceAwsLightsailDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disk_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disk_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	diskName := d.Get("disk_name").(string)
+	instanceName := d.Get("instance_name").(string)
+	input := &lightsail.AttachDiskInput{
+		DiskName:     aws.String(diskName),
+		DiskPath:     aws.String(d.Get("disk_path").(string)),
+		InstanceName: aws.String(instanceName),
+	}
+
+	log.Printf("[DEBUG] Attaching Lightsail Disk: %s", input)
+	resp, err := conn.AttachDisk(input)
+	if err != nil {
+		return fmt.Errorf("error attaching Lightsail Disk (%s) to Instance (%s): %s", diskName, instanceName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", diskName, instanceName))
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Disk attachment (%s) to complete: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailDiskAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	diskName, instanceName, err := resourceAwsLightsailDiskAttachmentParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+		DiskName: aws.String(diskName),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Disk (%s) not found, removing attachment from state", diskName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Disk (%s): %s", diskName, err)
+	}
+
+	if resp == nil || resp.Disk == nil || !aws.BoolValue(resp.Disk.IsAttached) || aws.StringValue(resp.Disk.AttachedTo) != instanceName {
+		log.Printf("[WARN] Lightsail Disk (%s) is not attached to Instance (%s), removing from state", diskName, instanceName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("disk_name", diskName)
+	d.Set("instance_name", instanceName)
+	d.Set("disk_path", resp.Disk.Path)
+
+	return nil
+}
+
+func resourceAwsLightsailDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	diskName, _, err := resourceAwsLightsailDiskAttachmentParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Detaching Lightsail Disk: %s", diskName)
+	resp, err := conn.DetachDisk(&lightsail.DetachDiskInput{
+		DiskName: aws.String(diskName),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error detaching Lightsail Disk (%s): %s", diskName, err)
+	}
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Disk (%s) to be detached: %s", diskName, err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailDiskAttachmentParseId(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected DISK_NAME,INSTANCE_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
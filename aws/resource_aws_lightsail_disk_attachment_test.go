@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailDiskAttachment_basic(t *testing.T) {
+	var disk lightsail.Disk
+	diskName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	resourceName := "aws_lightsail_disk_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskAttachmentConfig_basic(diskName, instanceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskAttachmentExists(resourceName, &disk),
+					resource.TestCheckResourceAttr(resourceName, "disk_name", diskName),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", instanceName),
+					resource.TestCheckResourceAttr(resourceName, "disk_path", "/dev/xvdf"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDiskAttachmentExists(n string, disk *lightsail.Disk) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Disk Attachment ID is set")
+		}
+
+		diskName, instanceName, err := resourceAwsLightsailDiskAttachmentParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(diskName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.Disk == nil {
+			return fmt.Errorf("Disk (%s) not found", diskName)
+		}
+
+		if aws.StringValue(resp.Disk.AttachedTo) != instanceName {
+			return fmt.Errorf("Disk (%s) not attached to Instance (%s)", diskName, instanceName)
+		}
+
+		*disk = *resp.Disk
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDiskAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk_attachment" {
+			continue
+		}
+
+		diskName, _, err := resourceAwsLightsailDiskAttachmentParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(diskName),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.Disk != nil && aws.BoolValue(resp.Disk.IsAttached) {
+			return fmt.Errorf("Lightsail Disk %q is still attached (to %q)", diskName, aws.StringValue(resp.Disk.AttachedTo))
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDiskAttachmentConfig_basic(diskName, instanceName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  size_in_gb        = 8
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "amazon_linux_2017_03_1_1"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_disk_attachment" "test" {
+  disk_name     = "${aws_lightsail_disk.test.name}"
+  instance_name = "${aws_lightsail_instance.test.name}"
+  disk_path     = "/dev/xvdf"
+}
+`, diskName, instanceName)
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailDisk_basic(t *testing.T) {
+	var disk lightsail.Disk
+	diskName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	resourceName := "aws_lightsail_disk.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskConfig_basic(diskName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskExists(resourceName, &disk),
+					resource.TestCheckResourceAttr(resourceName, "name", diskName),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone", "us-east-1b"),
+					resource.TestCheckResourceAttr(resourceName, "size_in_gb", "8"),
+					resource.TestCheckResourceAttr(resourceName, "is_attached", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDiskExists(n string, disk *lightsail.Disk) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Disk ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.Disk == nil {
+			return fmt.Errorf("Disk (%s) not found", rs.Primary.ID)
+		}
+
+		*disk = *resp.Disk
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDiskDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk" {
+			continue
+		}
+
+		resp, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.Disk != nil {
+			return fmt.Errorf("Lightsail Disk %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDiskConfig_basic(diskName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  size_in_gb        = 8
+}
+`, diskName)
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLightsailDomainEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDomainEntryCreate,
+		Read:   resourceAwsLightsailDomainEntryRead,
+		Delete: resourceAwsLightsailDomainEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A",
+					"CNAME",
+					"MX",
+					"NS",
+					"SOA",
+					"SRV",
+					"TXT",
+				}, false),
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_alias": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDomainEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	domainName := d.Get("domain_name").(string)
+	input := &lightsail.CreateDomainEntryInput{
+		DomainName: aws.String(domainName),
+		DomainEntry: &lightsail.DomainEntry{
+			IsAlias: aws.Bool(d.Get("is_alias").(bool)),
+			Name:    aws.String(d.Get("name").(string)),
+			Target:  aws.String(d.Get("target").(string)),
+			Type:    aws.String(d.Get("type").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Domain Entry: %s", input)
+	resp, err := conn.CreateDomainEntry(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Domain Entry in Domain (%s): %s", domainName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s,%s", domainName, d.Get("name").(string), d.Get("type").(string), d.Get("target").(string)))
+
+	if err := waitForLightsailOperations([]*lightsail.Operation{resp.Operation}, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Domain Entry (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailDomainEntryRead(d, meta)
+}
+
+func resourceAwsLightsailDomainEntryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	domainName, name, entryType, target, err := resourceAwsLightsailDomainEntryParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetDomain(&lightsail.GetDomainInput{
+		DomainName: aws.String(domainName),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Domain (%s) not found, removing Domain Entry from state", domainName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Domain (%s): %s", domainName, err)
+	}
+
+	var entry *lightsail.DomainEntry
+	if resp != nil && resp.Domain != nil {
+		for _, e := range resp.Domain.DomainEntries {
+			if strings.EqualFold(strings.TrimSuffix(aws.StringValue(e.Name), "."), strings.TrimSuffix(name, ".")) &&
+				aws.StringValue(e.Type) == entryType &&
+				aws.StringValue(e.Target) == target {
+				entry = e
+				break
+			}
+		}
+	}
+
+	if entry == nil {
+		log.Printf("[WARN] Lightsail Domain Entry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("domain_name", domainName)
+	d.Set("name", name)
+	d.Set("type", entry.Type)
+	d.Set("target", entry.Target)
+	d.Set("is_alias", aws.BoolValue(entry.IsAlias))
+
+	return nil
+}
+
+func resourceAwsLightsailDomainEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	domainName, name, entryType, target, err := resourceAwsLightsailDomainEntryParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lightsail.DeleteDomainEntryInput{
+		DomainName: aws.String(domainName),
+		DomainEntry: &lightsail.DomainEntry{
+			IsAlias: aws.Bool(d.Get("is_alias").(bool)),
+			Name:    aws.String(name),
+			Target:  aws.String(target),
+			Type:    aws.String(entryType),
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Domain Entry: %s", input)
+	resp, err := conn.DeleteDomainEntry(input)
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Domain Entry (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLightsailOperations([]*lightsail.Operation{resp.Operation}, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Domain Entry (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsLightsailDomainEntryParseId splits the ID into the domain name,
+// entry name, type and target. The target comes last, as TXT targets may
+// contain commas.
+func resourceAwsLightsailDomainEntryParseId(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, ",", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("Unexpected format of ID (%s), expected DOMAIN_NAME,NAME,TYPE,TARGET", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
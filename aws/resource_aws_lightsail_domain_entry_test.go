@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsLightsailDomainEntryParseId(t *testing.T) {
+	domainName, name, entryType, target, err := resourceAwsLightsailDomainEntryParseId("example.com,www.example.com,TXT,v=spf1 a,mx ~all")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if domainName != "example.com" || name != "www.example.com" || entryType != "TXT" || target != "v=spf1 a,mx ~all" {
+		t.Fatalf("unexpected parts: %q, %q, %q, %q", domainName, name, entryType, target)
+	}
+
+	for _, id := range []string{"", "example.com", "example.com,www.example.com,A", "example.com,,A,127.0.0.1"} {
+		if _, _, _, _, err := resourceAwsLightsailDomainEntryParseId(id); err == nil {
+			t.Errorf("expected error for ID %q", id)
+		}
+	}
+}
+
+func TestAccAWSLightsailDomainEntry_basic(t *testing.T) {
+	var entry lightsail.DomainEntry
+	domainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+	resourceName := "aws_lightsail_domain_entry.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDomainEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDomainEntryConfig_basic(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDomainEntryExists(resourceName, &entry),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "name", "www."+domainName),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "target", "127.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "is_alias", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailDomainEntry_disappears(t *testing.T) {
+	var entry lightsail.DomainEntry
+	domainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+	resourceName := "aws_lightsail_domain_entry.test"
+
+	entryDestroy := func(*terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		_, err := conn.DeleteDomainEntry(&lightsail.DeleteDomainEntryInput{
+			DomainName:  aws.String(domainName),
+			DomainEntry: &entry,
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error deleting Lightsail Domain Entry in disappear test: %s", err)
+		}
+
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDomainEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDomainEntryConfig_basic(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDomainEntryExists(resourceName, &entry),
+					entryDestroy,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDomainEntryExists(n string, entry *lightsail.DomainEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Domain Entry ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		e, err := testAccAWSLightsailDomainEntryGet(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if e == nil {
+			return fmt.Errorf("Domain Entry (%s) not found", rs.Primary.ID)
+		}
+
+		*entry = *e
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDomainEntryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_domain_entry" {
+			continue
+		}
+
+		e, err := testAccAWSLightsailDomainEntryGet(conn, rs.Primary.ID)
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if e != nil {
+			return fmt.Errorf("Lightsail Domain Entry %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccAWSLightsailDomainEntryGet returns the domain entry with the given
+// resource ID, or nil if the domain has no such entry.
+func testAccAWSLightsailDomainEntryGet(conn *lightsail.Lightsail, id string) (*lightsail.DomainEntry, error) {
+	domainName, name, entryType, target, err := resourceAwsLightsailDomainEntryParseId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.GetDomain(&lightsail.GetDomainInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range resp.Domain.DomainEntries {
+		if strings.EqualFold(strings.TrimSuffix(aws.StringValue(e.Name), "."), name) &&
+			aws.StringValue(e.Type) == entryType &&
+			aws.StringValue(e.Target) == target {
+			return e, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func testAccAWSLightsailDomainEntryConfig_basic(domainName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_domain" "test" {
+  domain_name = "%[1]s"
+}
+
+resource "aws_lightsail_domain_entry" "test" {
+  domain_name = "${aws_lightsail_domain.test.domain_name}"
+  name        = "www.%[1]s"
+  type        = "A"
+  target      = "127.0.0.1"
+}
+`, domainName)
+}
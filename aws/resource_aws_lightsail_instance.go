@@ -262,3 +262,29 @@ func resourceAwsLightsailOperationRefreshFunc(
 		return o, *o.Operation.Status, nil
 	}
 }
+
+// waitForLightsailOperations waits for each of the given Lightsail
+// Operations, as returned from Create/Attach/Detach/Delete methods, to
+// complete.
+func waitForLightsailOperations(ops []*lightsail.Operation, timeout time.Duration, meta interface{}) error {
+	for _, op := range ops {
+		if op == nil {
+			continue
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"NotStarted", "Started"},
+			Target:     []string{"Completed", "Succeeded"},
+			Refresh:    resourceAwsLightsailOperationRefreshFunc(op.Id, meta),
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Operation (%s) to complete: %s", aws.StringValue(op.Id), err)
+		}
+	}
+
+	return nil
+}
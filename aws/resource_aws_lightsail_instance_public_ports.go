@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLightsailInstancePublicPorts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailInstancePublicPortsPut,
+		Read:   resourceAwsLightsailInstancePublicPortsRead,
+		Update: resourceAwsLightsailInstancePublicPortsPut,
+		Delete: resourceAwsLightsailInstancePublicPortsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port_info": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lightsail.NetworkProtocolAll,
+								lightsail.NetworkProtocolTcp,
+								lightsail.NetworkProtocolUdp,
+							}, false),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+				Set: resourceAwsLightsailInstancePublicPortsPortInfoHash,
+			},
+		},
+	}
+}
+
+// resourceAwsLightsailInstancePublicPortsPut replaces all of the instance's
+// open public ports with the configured set, closing any others.
+func resourceAwsLightsailInstancePublicPortsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	instanceName := d.Get("instance_name").(string)
+	input := &lightsail.PutInstancePublicPortsInput{
+		InstanceName: aws.String(instanceName),
+		PortInfos:    expandLightsailPortInfos(d.Get("port_info").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Putting Lightsail Instance public ports: %s", input)
+	resp, err := conn.PutInstancePublicPorts(input)
+	if err != nil {
+		return fmt.Errorf("error putting Lightsail Instance (%s) public ports: %s", instanceName, err)
+	}
+
+	d.SetId(instanceName)
+
+	if err := waitForLightsailOperations([]*lightsail.Operation{resp.Operation}, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Instance (%s) public ports to be updated: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailInstancePublicPortsRead(d, meta)
+}
+
+func resourceAwsLightsailInstancePublicPortsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	resp, err := conn.GetInstancePortStates(&lightsail.GetInstancePortStatesInput{
+		InstanceName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Instance (%s) not found, removing public ports from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Instance (%s) public ports: %s", d.Id(), err)
+	}
+
+	d.Set("instance_name", d.Id())
+
+	if err := d.Set("port_info", flattenLightsailInstancePortStates(resp.PortStates)); err != nil {
+		return fmt.Errorf("error setting port_info: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailInstancePublicPortsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	for _, portInfo := range expandLightsailPortInfos(d.Get("port_info").(*schema.Set).List()) {
+		input := &lightsail.CloseInstancePublicPortsInput{
+			InstanceName: aws.String(d.Id()),
+			PortInfo:     portInfo,
+		}
+
+		log.Printf("[DEBUG] Closing Lightsail Instance public ports: %s", input)
+		resp, err := conn.CloseInstancePublicPorts(input)
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error closing Lightsail Instance (%s) public ports: %s", d.Id(), err)
+		}
+
+		if err := waitForLightsailOperations([]*lightsail.Operation{resp.Operation}, 10*time.Minute, meta); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Instance (%s) public ports to be closed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandLightsailPortInfos(l []interface{}) []*lightsail.PortInfo {
+	portInfos := make([]*lightsail.PortInfo, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		portInfos = append(portInfos, &lightsail.PortInfo{
+			FromPort: aws.Int64(int64(m["from_port"].(int))),
+			Protocol: aws.String(m["protocol"].(string)),
+			ToPort:   aws.Int64(int64(m["to_port"].(int))),
+		})
+	}
+
+	return portInfos
+}
+
+func flattenLightsailInstancePortStates(portStates []*lightsail.InstancePortState) []interface{} {
+	result := make([]interface{}, 0, len(portStates))
+
+	for _, portState := range portStates {
+		if aws.StringValue(portState.State) != lightsail.PortStateOpen {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"from_port": int(aws.Int64Value(portState.FromPort)),
+			"protocol":  aws.StringValue(portState.Protocol),
+			"to_port":   int(aws.Int64Value(portState.ToPort)),
+		})
+	}
+
+	return result
+}
+
+func resourceAwsLightsailInstancePublicPortsPortInfoHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m["from_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["protocol"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["to_port"].(int)))
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailInstancePublicPorts_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	resourceName := "aws_lightsail_instance_public_ports.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailInstancePublicPortsConfig_basic(instanceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailInstancePublicPortsExists(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", instanceName),
+					resource.TestCheckResourceAttr(resourceName, "port_info.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLightsailInstancePublicPortsConfig_multiple(instanceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailInstancePublicPortsExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "port_info.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailInstancePublicPortsExists(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Instance Public Ports ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetInstancePortStates(&lightsail.GetInstancePortStatesInput{
+			InstanceName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if actual := len(flattenLightsailInstancePortStates(resp.PortStates)); actual != expected {
+			return fmt.Errorf("Expected %d open public ports on Instance (%s), got %d", expected, rs.Primary.ID, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLightsailInstancePublicPortsConfig_basic(instanceName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "amazon_linux_2017_03_1_1"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_instance_public_ports" "test" {
+  instance_name = "${aws_lightsail_instance.test.name}"
+
+  port_info {
+    protocol  = "tcp"
+    from_port = 80
+    to_port   = 80
+  }
+}
+`, instanceName)
+}
+
+func testAccAWSLightsailInstancePublicPortsConfig_multiple(instanceName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "amazon_linux_2017_03_1_1"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_instance_public_ports" "test" {
+  instance_name = "${aws_lightsail_instance.test.name}"
+
+  port_info {
+    protocol  = "tcp"
+    from_port = 80
+    to_port   = 80
+  }
+
+  port_info {
+    protocol  = "tcp"
+    from_port = 443
+    to_port   = 443
+  }
+}
+`, instanceName)
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLightsailLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerCreate,
+		Read:   resourceAwsLightsailLoadBalancerRead,
+		Update: resourceAwsLightsailLoadBalancerUpdate,
+		Delete: resourceAwsLightsailLoadBalancerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"health_check_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateLoadBalancerInput{
+		HealthCheckPath:  aws.String(d.Get("health_check_path").(string)),
+		InstancePort:     aws.Int64(int64(d.Get("instance_port").(int))),
+		LoadBalancerName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Load Balancer: %s", input)
+	resp, err := conn.CreateLoadBalancer(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Load Balancer (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLoadBalancerRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.LoadBalancer == nil {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, nil response from server, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	lb := resp.LoadBalancer
+
+	d.Set("name", lb.Name)
+	d.Set("instance_port", lb.InstancePort)
+	d.Set("health_check_path", lb.HealthCheckPath)
+
+	d.Set("arn", lb.Arn)
+	d.Set("created_at", aws.TimeValue(lb.CreatedAt).Format(time.RFC3339))
+	d.Set("dns_name", lb.DnsName)
+	d.Set("protocol", lb.Protocol)
+
+	if err := d.Set("public_ports", aws.Int64ValueSlice(lb.PublicPorts)); err != nil {
+		return fmt.Errorf("error setting public_ports: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	if d.HasChange("health_check_path") {
+		input := &lightsail.UpdateLoadBalancerAttributeInput{
+			AttributeName:    aws.String(lightsail.LoadBalancerAttributeNameHealthCheckPath),
+			AttributeValue:   aws.String(d.Get("health_check_path").(string)),
+			LoadBalancerName: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Lightsail Load Balancer attribute: %s", input)
+		resp, err := conn.UpdateLoadBalancerAttribute(input)
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Load Balancer (%s) health check path: %s", d.Id(), err)
+		}
+
+		if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailLoadBalancerRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Deleting Lightsail Load Balancer: %s", d.Id())
+	resp, err := conn.DeleteLoadBalancer(&lightsail.DeleteLoadBalancerInput{
+		LoadBalancerName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Load Balancer (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailLoadBalancerAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerAttachmentCreate,
+		Read:   resourceAwsLightsailLoadBalancerAttachmentRead,
+		Delete: resourceAwsLightsailLoadBalancerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName := d.Get("load_balancer_name").(string)
+	instanceName := d.Get("instance_name").(string)
+	input := &lightsail.AttachInstancesToLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	log.Printf("[DEBUG] Attaching Instance to Lightsail Load Balancer: %s", input)
+	resp, err := conn.AttachInstancesToLoadBalancer(input)
+	if err != nil {
+		return fmt.Errorf("error attaching Instance (%s) to Lightsail Load Balancer (%s): %s", instanceName, lbName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", lbName, instanceName))
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer attachment (%s) to complete: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLoadBalancerAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, instanceName, err := resourceAwsLightsailLoadBalancerAttachmentParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(lbName),
+	})
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing attachment from state", lbName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer (%s): %s", lbName, err)
+	}
+
+	found := false
+	if resp != nil && resp.LoadBalancer != nil {
+		for _, summary := range resp.LoadBalancer.InstanceHealthSummary {
+			if aws.StringValue(summary.InstanceName) == instanceName {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Instance (%s) is not attached to Lightsail Load Balancer (%s), removing from state", instanceName, lbName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("load_balancer_name", lbName)
+	d.Set("instance_name", instanceName)
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, instanceName, err := resourceAwsLightsailLoadBalancerAttachmentParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lightsail.DetachInstancesFromLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	log.Printf("[DEBUG] Detaching Instance from Lightsail Load Balancer: %s", input)
+	resp, err := conn.DetachInstancesFromLoadBalancer(input)
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error detaching Instance (%s) from Lightsail Load Balancer (%s): %s", instanceName, lbName, err)
+	}
+
+	if err := waitForLightsailOperations(resp.Operations, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Instance (%s) to be detached from Lightsail Load Balancer (%s): %s", instanceName, lbName, err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentParseId(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected LOAD_BALANCER_NAME,INSTANCE_NAME", id)
+	}
+	return parts[0], parts[1], nil
+}
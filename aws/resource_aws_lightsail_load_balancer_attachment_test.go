@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLoadBalancerAttachment_basic(t *testing.T) {
+	lbName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	resourceName := "aws_lightsail_load_balancer_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerAttachmentConfig_basic(lbName, instanceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_name", lbName),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", instanceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Load Balancer Attachment ID is set")
+		}
+
+		lbName, instanceName, err := resourceAwsLightsailLoadBalancerAttachmentParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(lbName),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, summary := range resp.LoadBalancer.InstanceHealthSummary {
+			if aws.StringValue(summary.InstanceName) == instanceName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Instance (%s) not attached to Load Balancer (%s)", instanceName, lbName)
+	}
+}
+
+func testAccCheckAWSLightsailLoadBalancerAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_load_balancer_attachment" {
+			continue
+		}
+
+		lbName, instanceName, err := resourceAwsLightsailLoadBalancerAttachmentParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(lbName),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, summary := range resp.LoadBalancer.InstanceHealthSummary {
+			if aws.StringValue(summary.InstanceName) == instanceName {
+				return fmt.Errorf("Instance (%s) is still attached to Lightsail Load Balancer (%s)", instanceName, lbName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailLoadBalancerAttachmentConfig_basic(lbName, instanceName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_lightsail_load_balancer" "test" {
+  name          = "%s"
+  instance_port = 80
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = "%s"
+  availability_zone = "us-east-1b"
+  blueprint_id      = "amazon_linux_2017_03_1_1"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_load_balancer_attachment" "test" {
+  load_balancer_name = "${aws_lightsail_load_balancer.test.name}"
+  instance_name      = "${aws_lightsail_instance.test.name}"
+}
+`, lbName, instanceName)
+}
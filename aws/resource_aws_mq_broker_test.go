@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -77,38 +78,64 @@ func testSweepMqBrokers(region string) error {
 	}
 	conn := client.(*AWSClient).mqconn
 
-	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
+	var brokerIDs []string
+	input := &mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
-	})
-	if err != nil {
-		return fmt.Errorf("Error listing MQ brokers: %s", err)
+	}
+	for {
+		resp, err := conn.ListBrokers(input)
+		if err != nil {
+			return fmt.Errorf("Error listing MQ brokers: %s", err)
+		}
+
+		for _, bs := range resp.BrokerSummaries {
+			if strings.HasPrefix(*bs.BrokerName, "tf-acc-test-") {
+				brokerIDs = append(brokerIDs, *bs.BrokerId)
+			}
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
 	}
 
-	if len(resp.BrokerSummaries) == 0 {
+	if len(brokerIDs) == 0 {
 		log.Print("[DEBUG] No MQ brokers found to sweep")
 		return nil
 	}
-	log.Printf("[DEBUG] %d MQ brokers found", len(resp.BrokerSummaries))
+	log.Printf("[DEBUG] %d MQ brokers found", len(brokerIDs))
 
-	for _, bs := range resp.BrokerSummaries {
-		if !strings.HasPrefix(*bs.BrokerName, "tf-acc-test-") {
-			continue
+	if testSweepDryRun() {
+		for _, id := range brokerIDs {
+			log.Printf("[INFO] Would delete MQ broker %s", id)
 		}
+		return nil
+	}
 
-		log.Printf("[INFO] Deleting MQ broker %s", *bs.BrokerId)
+	// Start all deletions before waiting, as each one takes several minutes.
+	var errors error
+	var deleted []string
+	for _, id := range brokerIDs {
+		log.Printf("[INFO] Deleting MQ broker %s", id)
 		_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
-			BrokerId: bs.BrokerId,
+			BrokerId: aws.String(id),
 		})
 		if err != nil {
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting MQ broker %s: %s", id, err))
+			continue
 		}
-		err = waitForMqBrokerDeletion(conn, *bs.BrokerId)
+		deleted = append(deleted, id)
+	}
+
+	for _, id := range deleted {
+		err = waitForMqBrokerDeletion(conn, id)
 		if err != nil {
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error waiting for MQ broker %s to be deleted: %s", id, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestDiffAwsMqBrokerUsers(t *testing.T) {
@@ -79,6 +79,12 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(*ng.NatGatewayId)
 	log.Printf("[INFO] NAT Gateway ID: %s", d.Id())
 
+	// Tag the NAT Gateway while it is still pending so that it can be
+	// identified (e.g. by cost allocation or cleanup tooling) from the start
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("Error tagging NAT Gateway (%s): %s", d.Id(), err)
+	}
+
 	// Wait for the NAT Gateway to become available
 	log.Printf("[DEBUG] Waiting for NAT Gateway (%s) to become available", d.Id())
 	stateConf := &resource.StateChangeConf{
@@ -92,8 +98,7 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to become available: %s", d.Id(), err)
 	}
 
-	// Update our attributes and return
-	return resourceAwsNatGatewayUpdate(d, meta)
+	return resourceAwsNatGatewayRead(d, meta)
 }
 
 func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
@@ -5,10 +5,12 @@ import (
 	"log"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -27,6 +29,7 @@ func testSweepNatGateways(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	var ids []string
 	req := &ec2.DescribeNatGatewaysInput{
 		Filter: []*ec2.Filter{
 			{
@@ -37,28 +40,66 @@ func testSweepNatGateways(region string) error {
 			},
 		},
 	}
-	resp, err := conn.DescribeNatGateways(req)
+	err = conn.DescribeNatGatewaysPages(req, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, natGateway := range page.NatGateways {
+			if *natGateway.State == ec2.NatGatewayStateDeleted {
+				continue
+			}
+			ids = append(ids, *natGateway.NatGatewayId)
+		}
+		return !lastPage
+	})
 	if err != nil {
 		return fmt.Errorf("Error describing NAT Gateways: %s", err)
 	}
 
-	if len(resp.NatGateways) == 0 {
+	if len(ids) == 0 {
 		log.Print("[DEBUG] No AWS NAT Gateways to sweep")
 		return nil
 	}
 
-	for _, natGateway := range resp.NatGateways {
+	if testSweepDryRun() {
+		for _, id := range ids {
+			log.Printf("[INFO] Would delete NAT Gateway (%s)", id)
+		}
+		return nil
+	}
+
+	// Start all deletions before waiting, so that the subnets and VPCs swept
+	// afterwards are no longer in use.
+	var errors error
+	var deleted []string
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting NAT Gateway (%s)", id)
 		_, err := conn.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
-			NatGatewayId: natGateway.NatGatewayId,
+			NatGatewayId: aws.String(id),
 		})
 		if err != nil {
-			return fmt.Errorf(
+			errors = multierror.Append(errors, fmt.Errorf(
 				"Error deleting NAT Gateway (%s): %s",
-				*natGateway.NatGatewayId, err)
+				id, err))
+			continue
 		}
+		deleted = append(deleted, id)
 	}
 
-	return nil
+	for _, id := range deleted {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"deleting"},
+			Target:     []string{"deleted"},
+			Refresh:    NGStateRefreshFunc(conn, id),
+			Timeout:    30 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf(
+				"Error waiting for NAT Gateway (%s) to delete: %s",
+				id, err))
+		}
+	}
+
+	return errors
 }
 
 func TestAccAWSNatGateway_basic(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -26,6 +27,8 @@ func testSweepNetworkAcls(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	// DescribeNetworkAcls returns every matching Network ACL, it isn't
+	// paginated.
 	req := &ec2.DescribeNetworkAclsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -46,63 +49,77 @@ func testSweepNetworkAcls(region string) error {
 		return nil
 	}
 
+	var errors error
 	for _, nacl := range resp.NetworkAcls {
-		// Delete rules first
-		for _, entry := range nacl.Entries {
-			// This is a magic number for "ALL traffic" rule which can't be deleted
-			if *entry.RuleNumber == 32767 {
-				log.Printf("[DEBUG] Skipping Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
-				continue
-			}
-
-			log.Printf("[INFO] Deleting Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
-			_, err := conn.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{
-				NetworkAclId: nacl.NetworkAclId,
-				Egress:       entry.Egress,
-				RuleNumber:   entry.RuleNumber,
-			})
-			if err != nil {
-				return fmt.Errorf(
-					"Error deleting Network ACL rule (%s / %d): %s",
-					*nacl.NetworkAclId, *entry.RuleNumber, err)
-			}
+		if err := testSweepNetworkAcl(conn, nacl); err != nil {
+			errors = multierror.Append(errors, err)
 		}
+	}
 
-		// Disassociate subnets
-		log.Printf("[DEBUG] Found %d Network ACL associations for %q", len(nacl.Associations), *nacl.NetworkAclId)
-		for _, a := range nacl.Associations {
-			log.Printf("[DEBUG] Replacing subnet associations for Network ACL %q", *nacl.NetworkAclId)
-			defaultAcl, err := getDefaultNetworkAcl(*nacl.VpcId, conn)
-			if err != nil {
-				return fmt.Errorf("Failed to find default Network ACL for VPC %q", *nacl.VpcId)
-			}
-			_, err = conn.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
-				NetworkAclId:  defaultAcl.NetworkAclId,
-				AssociationId: a.NetworkAclAssociationId,
-			})
-			if err != nil {
-				return fmt.Errorf("Failed to replace subnet association for Network ACL %q: %s",
-					*nacl.NetworkAclId, err)
-			}
-		}
+	return errors
+}
+
+func testSweepNetworkAcl(conn *ec2.EC2, nacl *ec2.NetworkAcl) error {
+	if testSweepDryRun() {
+		log.Printf("[INFO] Would delete rules, subnet associations and (unless default) Network ACL: %q", *nacl.NetworkAclId)
+		return nil
+	}
 
-		// Default Network ACLs will be deleted along with VPC
-		if *nacl.IsDefault {
-			log.Printf("[DEBUG] Skipping default Network ACL: %q", *nacl.NetworkAclId)
+	// Delete rules first
+	for _, entry := range nacl.Entries {
+		// This is a magic number for "ALL traffic" rule which can't be deleted
+		if *entry.RuleNumber == 32767 {
+			log.Printf("[DEBUG] Skipping Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
 			continue
 		}
 
-		log.Printf("[INFO] Deleting Network ACL: %q", *nacl.NetworkAclId)
-		_, err := conn.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
+		log.Printf("[INFO] Deleting Network ACL rule: %q / %d", *nacl.NetworkAclId, *entry.RuleNumber)
+		_, err := conn.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{
 			NetworkAclId: nacl.NetworkAclId,
+			Egress:       entry.Egress,
+			RuleNumber:   entry.RuleNumber,
 		})
 		if err != nil {
 			return fmt.Errorf(
-				"Error deleting Network ACL (%s): %s",
+				"Error deleting Network ACL rule (%s / %d): %s",
+				*nacl.NetworkAclId, *entry.RuleNumber, err)
+		}
+	}
+
+	// Disassociate subnets
+	log.Printf("[DEBUG] Found %d Network ACL associations for %q", len(nacl.Associations), *nacl.NetworkAclId)
+	for _, a := range nacl.Associations {
+		log.Printf("[DEBUG] Replacing subnet associations for Network ACL %q", *nacl.NetworkAclId)
+		defaultAcl, err := getDefaultNetworkAcl(*nacl.VpcId, conn)
+		if err != nil {
+			return fmt.Errorf("Failed to find default Network ACL for VPC %q", *nacl.VpcId)
+		}
+		_, err = conn.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{
+			NetworkAclId:  defaultAcl.NetworkAclId,
+			AssociationId: a.NetworkAclAssociationId,
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to replace subnet association for Network ACL %q: %s",
 				*nacl.NetworkAclId, err)
 		}
 	}
 
+	// Default Network ACLs will be deleted along with VPC
+	if *nacl.IsDefault {
+		log.Printf("[DEBUG] Skipping default Network ACL: %q", *nacl.NetworkAclId)
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Network ACL: %q", *nacl.NetworkAclId)
+	_, err := conn.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
+		NetworkAclId: nacl.NetworkAclId,
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"Error deleting Network ACL (%s): %s",
+			*nacl.NetworkAclId, err)
+	}
+
 	return nil
 }
 
@@ -119,8 +119,9 @@ func resourceAwsOpsworksApplication() *schema.Resource {
 				Optional: true,
 			},
 			"data_source_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArnService("opsworks", "rds"),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -103,9 +103,10 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 			},
 
 			"ecs_cluster_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArnServiceResource("ecs", "cluster/"),
 			},
 
 			"elastic_ip": {
@@ -134,9 +135,10 @@ func resourceAwsOpsworksInstance() *schema.Resource {
 			},
 
 			"instance_profile_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamInstanceProfileArn,
 			},
 
 			"instance_type": {
@@ -31,8 +31,9 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 				Optional: true,
 			},
 			"user_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArnServiceResource("iam"),
 			},
 			"level": {
 				Type:     schema.TypeString,
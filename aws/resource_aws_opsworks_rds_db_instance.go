@@ -26,9 +26,10 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 				ForceNew: true,
 			},
 			"rds_db_instance_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnServiceResource("rds", "db:"),
 			},
 			"db_password": {
 				Type:      schema.TypeString,
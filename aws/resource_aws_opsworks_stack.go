@@ -4,11 +4,9 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -52,14 +50,16 @@ func resourceAwsOpsworksStack() *schema.Resource {
 			},
 
 			"service_role_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"default_instance_profile_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamInstanceProfileArn,
 			},
 
 			"color": {
@@ -438,36 +438,23 @@ func resourceAwsOpsworksStackCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Creating OpsWorks stack: %s", req)
 
-	var resp *opsworks.CreateStackOutput
-	err = resource.Retry(20*time.Minute, func() *resource.RetryError {
-		var cerr error
-		resp, cerr = client.CreateStack(req)
-		if cerr != nil {
-			if opserr, ok := cerr.(awserr.Error); ok {
-				// If Terraform is also managing the service IAM role,
-				// it may have just been created and not yet be
-				// propagated.
-				// AWS doesn't provide a machine-readable code for this
-				// specific error, so we're forced to do fragile message
-				// matching.
-				// The full error we're looking for looks something like
-				// the following:
-				// Service Role Arn: [...] is not yet propagated, please try again in a couple of minutes
-				propErr := "not yet propagated"
-				trustErr := "not the necessary trust relationship"
-				validateErr := "validate IAM role permission"
-				if opserr.Code() == "ValidationException" && (strings.Contains(opserr.Message(), trustErr) || strings.Contains(opserr.Message(), propErr) || strings.Contains(opserr.Message(), validateErr)) {
-					log.Printf("[INFO] Waiting for service IAM role to propagate")
-					return resource.RetryableError(cerr)
-				}
-			}
-			return resource.NonRetryableError(cerr)
-		}
-		return nil
+	// If Terraform is also managing the service IAM role, it may have just
+	// been created and not yet be propagated. AWS doesn't provide a
+	// machine-readable code for this specific error, so we're forced to do
+	// fragile message matching. The full error we're looking for looks
+	// something like the following:
+	// Service Role Arn: [...] is not yet propagated, please try again in a couple of minutes
+	raw, err := retryOnIamPropagation(20*time.Minute, []awsErrMatcher{
+		{"ValidationException", "not yet propagated"},
+		{"ValidationException", "not the necessary trust relationship"},
+		{"ValidationException", "validate IAM role permission"},
+	}, func() (interface{}, error) {
+		return client.CreateStack(req)
 	})
 	if err != nil {
 		return err
 	}
+	resp := raw.(*opsworks.CreateStackOutput)
 
 	stackId := *resp.StackId
 	d.SetId(stackId)
@@ -19,9 +19,10 @@ func resourceAwsOpsworksUserProfile() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"user_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnServiceResource("iam"),
 			},
 
 			"allow_self_management": {
@@ -223,7 +223,7 @@ func resourceAwsRDSCluster() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			"replication_source_identifier": {
@@ -703,6 +703,21 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			return fmt.Errorf("Failed to modify RDS Cluster (%s): %s", d.Id(), err)
 		}
+
+		log.Printf("[INFO] Waiting for RDS Cluster (%s) to be available", d.Id())
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    resourceAwsRdsClusterUpdatePendingStates,
+			Target:     []string{"available"},
+			Refresh:    resourceAwsRDSClusterStateRefreshFunc(d, meta),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 10 * time.Second,
+			Delay:      10 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("iam_roles") {
@@ -888,6 +903,13 @@ var resourceAwsRdsClusterCreatePendingStates = []string{
 	"resetting-master-credentials",
 }
 
+var resourceAwsRdsClusterUpdatePendingStates = []string{
+	"backing-up",
+	"modifying",
+	"resetting-master-credentials",
+	"upgrading",
+}
+
 var resourceAwsRdsClusterDeletePendingStates = []string{
 	"available",
 	"deleting",
@@ -136,9 +136,10 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 			},
 
 			"monitoring_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"preferred_maintenance_window": {
@@ -191,7 +192,7 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateKmsKeyArn,
 			},
 
 			"tags": tagsSchema(),
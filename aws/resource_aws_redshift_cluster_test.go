@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -31,30 +32,64 @@ func testSweepRedshiftClusters(region string) error {
 	}
 	conn := client.(*AWSClient).redshiftconn
 
-	return conn.DescribeClustersPages(&redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, isLast bool) bool {
-		if len(resp.Clusters) == 0 {
-			log.Print("[DEBUG] No Redshift clusters to sweep")
-			return false
-		}
-
+	var ids []string
+	err = conn.DescribeClustersPages(&redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, isLast bool) bool {
 		for _, c := range resp.Clusters {
-			id := *c.ClusterIdentifier
-			if !strings.HasPrefix(id, "tf-redshift-cluster-") {
-				continue
-			}
-
-			input := &redshift.DeleteClusterInput{
-				ClusterIdentifier:        c.ClusterIdentifier,
-				SkipFinalClusterSnapshot: aws.Bool(true),
-			}
-			_, err := conn.DeleteCluster(input)
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Redshift cluster (%s): %s",
-					*c.ClusterIdentifier, err)
+			if strings.HasPrefix(*c.ClusterIdentifier, "tf-redshift-cluster-") {
+				ids = append(ids, *c.ClusterIdentifier)
 			}
 		}
 		return !isLast
 	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving Redshift clusters: %s", err)
+	}
+
+	if len(ids) == 0 {
+		log.Print("[DEBUG] No Redshift clusters to sweep")
+		return nil
+	}
+
+	if testSweepDryRun() {
+		for _, id := range ids {
+			log.Printf("[INFO] Would delete Redshift cluster (%s)", id)
+		}
+		return nil
+	}
+
+	// Start all deletions before waiting, as each one takes several minutes.
+	var errors error
+	var deleted []string
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting Redshift cluster (%s)", id)
+		input := &redshift.DeleteClusterInput{
+			ClusterIdentifier:        aws.String(id),
+			SkipFinalClusterSnapshot: aws.Bool(true),
+		}
+		_, err := conn.DeleteCluster(input)
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Failed deleting Redshift cluster (%s): %s",
+				id, err))
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+
+	for _, id := range deleted {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"available", "creating", "deleting", "rebooting", "resizing", "renaming", "final-snapshot"},
+			Target:     []string{"destroyed"},
+			Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(id, conn),
+			Timeout:    40 * time.Minute,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error waiting for Redshift cluster (%s) to be deleted: %s",
+				id, err))
+		}
+	}
+
+	return errors
 }
 
 func TestValidateRedshiftClusterDbName(t *testing.T) {
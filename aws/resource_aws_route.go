@@ -230,6 +230,18 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 		return nil
 	})
+	if isAWSErr(err, "RouteAlreadyExists", "") {
+		// The destination is already routed, e.g. by an in-line route of an
+		// aws_route_table resource.
+		destination := d.Get("destination_cidr_block").(string)
+		if destination == "" {
+			destination = d.Get("destination_ipv6_cidr_block").(string)
+		}
+		return fmt.Errorf("A route for destination %q already exists in Route Table (%s). "+
+			"A Route Table with in-line routes can't be used in conjunction with Route resources "+
+			"managing the same destination; remove the in-line route or import the existing route.",
+			destination, d.Get("route_table_id").(string))
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating route: %s", err)
 	}
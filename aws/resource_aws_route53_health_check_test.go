@@ -82,6 +82,10 @@ func TestAccAWSRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 				Config: testAccRoute53HealthCheckConfig_withChildHealthChecks,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRoute53HealthCheckExists("aws_route53_health_check.foo"),
+					resource.TestCheckResourceAttr(
+						"aws_route53_health_check.foo", "child_healthchecks.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_route53_health_check.foo", "child_health_threshold", "1"),
 				),
 			},
 		},
@@ -134,6 +138,8 @@ func TestAccAWSRoute53HealthCheck_CloudWatchAlarmCheck(t *testing.T) {
 					testAccCheckRoute53HealthCheckExists("aws_route53_health_check.foo"),
 					resource.TestCheckResourceAttr(
 						"aws_route53_health_check.foo", "cloudwatch_alarm_name", "cloudwatch-healthcheck-alarm"),
+					resource.TestCheckResourceAttr(
+						"aws_route53_health_check.foo", "insufficient_data_health_status", "Healthy"),
 				),
 			},
 		},
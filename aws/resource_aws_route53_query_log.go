@@ -24,7 +24,7 @@ func resourceAwsRoute53QueryLog() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateCloudWatchLogGroupArn,
 			},
 
 			"zone_id": {
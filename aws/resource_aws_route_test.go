@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -293,6 +294,28 @@ func TestAccAWSRoute_doesNotCrashWithVPCEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccAWSRoute_conflictsWithRouteTableInlineRoute(t *testing.T) {
+	var routeTable ec2.RouteTable
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigInlineRouteTable,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists("aws_route_table.test", &routeTable),
+				),
+			},
+			{
+				Config:      testAccAWSRouteConfigInlineRouteTable + testAccAWSRouteConfigConflictingRoute,
+				ExpectError: regexp.MustCompile(`A route for destination "10.3.0.0/16" already exists`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -801,3 +824,38 @@ resource "aws_vpc_endpoint" "baz" {
   route_table_ids = ["${aws_route_table.foo.id}"]
 }
 `)
+
+const testAccAWSRouteConfigInlineRouteTable = `
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-route-inline-conflict"
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Name = "terraform-testacc-route-inline-conflict"
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  route {
+    cidr_block = "10.3.0.0/16"
+    gateway_id = "${aws_internet_gateway.test.id}"
+  }
+}
+`
+
+const testAccAWSRouteConfigConflictingRoute = `
+resource "aws_route" "test" {
+  route_table_id         = "${aws_route_table.test.id}"
+  destination_cidr_block = "10.3.0.0/16"
+  gateway_id             = "${aws_internet_gateway.test.id}"
+}
+`
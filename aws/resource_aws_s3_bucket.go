@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/jen20/awspolicyequivalence"
 )
 
 func resourceAwsS3Bucket() *schema.Resource {
@@ -31,6 +34,8 @@ func resourceAwsS3Bucket() *schema.Resource {
 			State: resourceAwsS3BucketImportState,
 		},
 
+		CustomizeDiff: resourceAwsS3BucketCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:          schema.TypeString,
@@ -65,7 +70,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ValidateFunc:     validateJsonString,
+				ValidateFunc:     validateAwsPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 
@@ -373,21 +378,39 @@ func resourceAwsS3Bucket() *schema.Resource {
 												"bucket": {
 													Type:         schema.TypeString,
 													Required:     true,
-													ValidateFunc: validateArn,
+													ValidateFunc: validateS3Arn,
 												},
 												"storage_class": {
-													Type:     schema.TypeString,
-													Optional: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														s3.StorageClassStandard,
-														s3.StorageClassStandardIa,
-														s3.StorageClassReducedRedundancy,
-													}, false),
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateS3ObjectStorageClass(),
 												},
 												"replica_kms_key_id": {
 													Type:     schema.TypeString,
 													Optional: true,
 												},
+												"access_control_translation": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"owner": {
+																Type:     schema.TypeString,
+																Required: true,
+																ValidateFunc: validation.StringInSlice([]string{
+																	s3.OwnerOverrideDestination,
+																}, false),
+															},
+														},
+													},
+												},
+												"account_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateAwsAccountId,
+												},
 											},
 										},
 									},
@@ -482,6 +505,40 @@ func resourceAwsS3Bucket() *schema.Resource {
 	}
 }
 
+// resourceAwsS3BucketCustomizeDiff rejects replication destinations that set
+// access_control_translation without account_id, which S3 requires.
+func resourceAwsS3BucketCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// Only changed rules are checked. Their keys look like
+	// replication_configuration.0.rules.<hash>.destination.<hash>.access_control_translation.#
+	for _, k := range diff.GetChangedKeysPrefix("replication_configuration") {
+		if !strings.HasSuffix(k, ".access_control_translation.#") {
+			continue
+		}
+		if n, ok := diff.Get(k).(int); !ok || n == 0 {
+			continue
+		}
+
+		destination := strings.TrimSuffix(k, ".access_control_translation.#")
+		if diff.Get(destination+".account_id").(string) != "" {
+			continue
+		}
+
+		// The hash of a set element which holds a value that is not known
+		// until apply (e.g. an account_id interpolated from another resource)
+		// starts with "~". Such a value reads as empty, so leave the check of
+		// that rule to S3.
+		parts := strings.Split(destination, ".")
+		if strings.HasPrefix(parts[len(parts)-1], "~") {
+			continue
+		}
+
+		rule := strings.Join(parts[:len(parts)-2], ".")
+		return fmt.Errorf("replication_configuration rule %q: account_id must be set when access_control_translation is set", diff.Get(rule+".id").(string))
+	}
+
+	return nil
+}
+
 func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -1208,6 +1265,18 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 		if err != nil {
 			return fmt.Errorf("Error putting S3 policy: %s", err)
 		}
+
+		_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"NoSuchBucketPolicy"}, func() (interface{}, error) {
+			return s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+				Bucket: aws.String(bucket),
+			})
+		}, func(out interface{}) bool {
+			equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(out.(*s3.GetBucketPolicyOutput).Policy), policy)
+			return err == nil && equivalent
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 bucket (%s) policy: %s", bucket, err)
+		}
 	} else {
 		log.Printf("[DEBUG] S3 bucket: %s, delete policy: %s", bucket, policy)
 		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
@@ -1240,6 +1309,15 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		if err != nil {
 			return fmt.Errorf("Error deleting S3 CORS: %s", err)
 		}
+
+		err = waitForS3BucketConfigurationDeleted("NoSuchCORSConfiguration", func() (interface{}, error) {
+			return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 bucket (%s) CORS deletion: %s", bucket, err)
+		}
 	} else {
 		// Put CORS
 		rules := make([]*s3.CORSRule, 0, len(rawCors))
@@ -1284,6 +1362,17 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		if err != nil {
 			return fmt.Errorf("Error putting S3 CORS: %s", err)
 		}
+
+		_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"NoSuchCORSConfiguration"}, func() (interface{}, error) {
+			return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+				Bucket: aws.String(bucket),
+			})
+		}, func(out interface{}) bool {
+			return s3CorsRulesEqual(rules, out.(*s3.GetBucketCorsOutput).CORSRules)
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 bucket (%s) CORS: %s", bucket, err)
+		}
 	}
 
 	return nil
@@ -1378,6 +1467,17 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 		return fmt.Errorf("Error putting S3 website: %s", err)
 	}
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"NoSuchWebsiteConfiguration"}, func() (interface{}, error) {
+		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		return s3WebsiteConfigurationEqual(websiteConfiguration, out.(*s3.GetBucketWebsiteOutput))
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) website: %s", bucket, err)
+	}
+
 	return nil
 }
 
@@ -1394,6 +1494,15 @@ func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) err
 		return fmt.Errorf("Error deleting S3 website: %s", err)
 	}
 
+	err = waitForS3BucketConfigurationDeleted("NoSuchWebsiteConfiguration", func() (interface{}, error) {
+		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(bucket),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) website deletion: %s", bucket, err)
+	}
+
 	d.Set("website_endpoint", "")
 	d.Set("website_domain", "")
 
@@ -1527,6 +1636,22 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 		return fmt.Errorf("Error putting S3 versioning: %s", err)
 	}
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, nil, func() (interface{}, error) {
+		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		versioning := out.(*s3.GetBucketVersioningOutput)
+		// MFADelete is not returned unless it has been enabled at some point.
+		if aws.StringValue(vc.MFADelete) == s3.MFADeleteEnabled && aws.StringValue(versioning.MFADelete) != s3.MFADeleteEnabled {
+			return false
+		}
+		return aws.StringValue(versioning.Status) == aws.StringValue(vc.Status)
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) versioning: %s", bucket, err)
+	}
+
 	return nil
 }
 
@@ -1562,6 +1687,23 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 		return fmt.Errorf("Error putting S3 logging: %s", err)
 	}
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, nil, func() (interface{}, error) {
+		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		actual := out.(*s3.GetBucketLoggingOutput).LoggingEnabled
+		expected := loggingStatus.LoggingEnabled
+		if expected == nil || actual == nil {
+			return expected == nil && actual == nil
+		}
+		return aws.StringValue(actual.TargetBucket) == aws.StringValue(expected.TargetBucket) &&
+			aws.StringValue(actual.TargetPrefix) == aws.StringValue(expected.TargetPrefix)
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) logging: %s", bucket, err)
+	}
+
 	return nil
 }
 
@@ -1627,6 +1769,15 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 		if err != nil {
 			return fmt.Errorf("error removing S3 bucket server side encryption: %s", err)
 		}
+
+		err = waitForS3BucketConfigurationDeleted("ServerSideEncryptionConfigurationNotFoundError", func() (interface{}, error) {
+			return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 bucket (%s) server side encryption deletion: %s", bucket, err)
+		}
 		return nil
 	}
 
@@ -1668,6 +1819,17 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
 	}
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"ServerSideEncryptionConfigurationNotFoundError"}, func() (interface{}, error) {
+		return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		return s3ServerSideEncryptionRulesEqual(rules, out.(*s3.GetBucketEncryptionOutput).ServerSideEncryptionConfiguration)
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) server side encryption configuration: %s", bucket, err)
+	}
+
 	return nil
 }
 
@@ -1689,6 +1851,15 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		if err != nil {
 			return fmt.Errorf("Error removing S3 bucket replication: %s", err)
 		}
+
+		err = waitForS3BucketConfigurationDeleted("ReplicationConfigurationNotFoundError", func() (interface{}, error) {
+			return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 bucket (%s) replication deletion: %s", bucket, err)
+		}
 		return nil
 	}
 
@@ -1740,6 +1911,17 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 					ReplicaKmsKeyID: aws.String(replicaKmsKeyId.(string)),
 				}
 			}
+
+			if account, ok := bd["account_id"]; ok && account != "" {
+				ruleDestination.Account = aws.String(account.(string))
+			}
+
+			if aclTranslation, ok := bd["access_control_translation"].([]interface{}); ok && len(aclTranslation) > 0 {
+				aclTranslationValues := aclTranslation[0].(map[string]interface{})
+				ruleAclTranslation := &s3.AccessControlTranslation{}
+				ruleAclTranslation.Owner = aws.String(aclTranslationValues["owner"].(string))
+				ruleDestination.AccessControlTranslation = ruleAclTranslation
+			}
 		}
 		rcRule.Destination = ruleDestination
 
@@ -1775,6 +1957,17 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
 	}
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"ReplicationConfigurationNotFoundError"}, func() (interface{}, error) {
+		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		return s3ReplicationConfigurationEqual(rc, out.(*s3.GetBucketReplicationOutput).ReplicationConfiguration)
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) replication configuration: %s", bucket, err)
+	}
+
 	return nil
 }
 
@@ -1797,6 +1990,15 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		if err != nil {
 			return fmt.Errorf("Error removing S3 lifecycle: %s", err)
 		}
+
+		err = waitForS3BucketConfigurationDeleted("NoSuchLifecycleConfiguration", func() (interface{}, error) {
+			return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 bucket (%s) lifecycle deletion: %s", bucket, err)
+		}
 		return nil
 	}
 
@@ -1934,9 +2136,249 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
 	}
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"NoSuchLifecycleConfiguration"}, func() (interface{}, error) {
+		return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		return s3LifecycleRulesEqual(rules, out.(*s3.GetBucketLifecycleConfigurationOutput).Rules)
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) lifecycle configuration: %s", bucket, err)
+	}
+
 	return nil
 }
 
+// waitForS3BucketConfigurationDeleted waits until read, which gets a bucket
+// configuration, fails with the given "not found" error code.
+func waitForS3BucketConfigurationDeleted(code string, read func() (interface{}, error)) error {
+	_, err := retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, nil, func() (interface{}, error) {
+		out, err := read()
+		if isAWSErr(err, code, "") {
+			return nil, nil
+		}
+		return out, err
+	}, func(out interface{}) bool {
+		return out == nil
+	})
+	return err
+}
+
+// s3CorsRulesEqual reports whether the CORS rules read from S3 match the
+// rules which were put, in order.
+func s3CorsRulesEqual(expected, actual []*s3.CORSRule) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i, e := range expected {
+		a := actual[i]
+		if aws.Int64Value(e.MaxAgeSeconds) != aws.Int64Value(a.MaxAgeSeconds) ||
+			!reflect.DeepEqual(aws.StringValueSlice(e.AllowedHeaders), aws.StringValueSlice(a.AllowedHeaders)) ||
+			!reflect.DeepEqual(aws.StringValueSlice(e.AllowedMethods), aws.StringValueSlice(a.AllowedMethods)) ||
+			!reflect.DeepEqual(aws.StringValueSlice(e.AllowedOrigins), aws.StringValueSlice(a.AllowedOrigins)) ||
+			!reflect.DeepEqual(aws.StringValueSlice(e.ExposeHeaders), aws.StringValueSlice(a.ExposeHeaders)) {
+			return false
+		}
+	}
+	return true
+}
+
+// s3WebsiteConfigurationEqual reports whether the website configuration read
+// from S3 matches the configuration which was put.
+func s3WebsiteConfigurationEqual(expected *s3.WebsiteConfiguration, actual *s3.GetBucketWebsiteOutput) bool {
+	var expectedIndex, actualIndex, expectedError, actualError string
+	if expected.IndexDocument != nil {
+		expectedIndex = aws.StringValue(expected.IndexDocument.Suffix)
+	}
+	if actual.IndexDocument != nil {
+		actualIndex = aws.StringValue(actual.IndexDocument.Suffix)
+	}
+	if expected.ErrorDocument != nil {
+		expectedError = aws.StringValue(expected.ErrorDocument.Key)
+	}
+	if actual.ErrorDocument != nil {
+		actualError = aws.StringValue(actual.ErrorDocument.Key)
+	}
+	if expectedIndex != actualIndex || expectedError != actualError {
+		return false
+	}
+
+	if (expected.RedirectAllRequestsTo == nil) != (actual.RedirectAllRequestsTo == nil) {
+		return false
+	}
+	if e, a := expected.RedirectAllRequestsTo, actual.RedirectAllRequestsTo; e != nil {
+		if aws.StringValue(e.HostName) != aws.StringValue(a.HostName) || aws.StringValue(e.Protocol) != aws.StringValue(a.Protocol) {
+			return false
+		}
+	}
+
+	if len(expected.RoutingRules) != len(actual.RoutingRules) {
+		return false
+	}
+	if len(expected.RoutingRules) == 0 {
+		return true
+	}
+	expectedRules, err := normalizeRoutingRules(expected.RoutingRules)
+	if err != nil {
+		return false
+	}
+	actualRules, err := normalizeRoutingRules(actual.RoutingRules)
+	if err != nil {
+		return false
+	}
+	return expectedRules == actualRules
+}
+
+// s3ServerSideEncryptionRulesEqual reports whether the server side encryption
+// configuration read from S3 matches the rules which were put.
+func s3ServerSideEncryptionRulesEqual(expected []*s3.ServerSideEncryptionRule, actual *s3.ServerSideEncryptionConfiguration) bool {
+	if actual == nil || len(expected) != len(actual.Rules) {
+		return false
+	}
+	for i, e := range expected {
+		ed, ad := e.ApplyServerSideEncryptionByDefault, actual.Rules[i].ApplyServerSideEncryptionByDefault
+		if ed == nil || ad == nil {
+			if ed != ad {
+				return false
+			}
+			continue
+		}
+		if aws.StringValue(ed.SSEAlgorithm) != aws.StringValue(ad.SSEAlgorithm) ||
+			aws.StringValue(ed.KMSMasterKeyID) != aws.StringValue(ad.KMSMasterKeyID) {
+			return false
+		}
+	}
+	return true
+}
+
+// s3ReplicationConfigurationEqual reports whether the replication
+// configuration read from S3 matches the configuration which was put. Rules
+// are matched by ID; rules put without an ID, which S3 assigns one to, are
+// matched by content only.
+func s3ReplicationConfigurationEqual(expected, actual *s3.ReplicationConfiguration) bool {
+	if actual == nil || aws.StringValue(expected.Role) != aws.StringValue(actual.Role) || len(expected.Rules) != len(actual.Rules) {
+		return false
+	}
+
+	unmatched := make([]*s3.ReplicationRule, len(actual.Rules))
+	copy(unmatched, actual.Rules)
+	for _, e := range expected.Rules {
+		found := false
+		for i, a := range unmatched {
+			if aws.StringValue(e.ID) != "" && aws.StringValue(e.ID) != aws.StringValue(a.ID) {
+				continue
+			}
+			if s3ReplicationRuleKey(e) == s3ReplicationRuleKey(a) {
+				unmatched = append(unmatched[:i], unmatched[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// s3ReplicationRuleKey returns the settings of a replication rule, other than
+// its ID, which are compared after a put.
+func s3ReplicationRuleKey(r *s3.ReplicationRule) string {
+	var bucket, storageClass, account, replicaKmsKeyId, owner string
+	if dest := r.Destination; dest != nil {
+		bucket = aws.StringValue(dest.Bucket)
+		storageClass = aws.StringValue(dest.StorageClass)
+		account = aws.StringValue(dest.Account)
+		if dest.EncryptionConfiguration != nil {
+			replicaKmsKeyId = aws.StringValue(dest.EncryptionConfiguration.ReplicaKmsKeyID)
+		}
+		if dest.AccessControlTranslation != nil {
+			owner = aws.StringValue(dest.AccessControlTranslation.Owner)
+		}
+	}
+	var sseKmsStatus string
+	if ssc := r.SourceSelectionCriteria; ssc != nil && ssc.SseKmsEncryptedObjects != nil {
+		sseKmsStatus = aws.StringValue(ssc.SseKmsEncryptedObjects.Status)
+	}
+	return strings.Join([]string{
+		aws.StringValue(r.Prefix),
+		aws.StringValue(r.Status),
+		bucket,
+		storageClass,
+		account,
+		replicaKmsKeyId,
+		owner,
+		sseKmsStatus,
+	}, "|")
+}
+
+// s3LifecycleRulesEqual reports whether the lifecycle rules read from S3 match
+// the rules which were put. Rules are matched by ID and compared by content.
+func s3LifecycleRulesEqual(expected, actual []*s3.LifecycleRule) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	actualByID := make(map[string]string, len(actual))
+	for _, r := range actual {
+		actualByID[aws.StringValue(r.ID)] = s3LifecycleRuleKey(r)
+	}
+	for _, r := range expected {
+		if key, ok := actualByID[aws.StringValue(r.ID)]; !ok || key != s3LifecycleRuleKey(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// s3LifecycleRuleKey returns the settings of a lifecycle rule, other than its
+// ID, in a form which does not depend on the order of tags and transitions.
+func s3LifecycleRuleKey(r *s3.LifecycleRule) string {
+	prefix := aws.StringValue(r.Prefix)
+	var tags []string
+	if f := r.Filter; f != nil {
+		if f.And != nil {
+			prefix = aws.StringValue(f.And.Prefix)
+			for _, t := range f.And.Tags {
+				tags = append(tags, aws.StringValue(t.Key)+"="+aws.StringValue(t.Value))
+			}
+		} else {
+			prefix = aws.StringValue(f.Prefix)
+			if f.Tag != nil {
+				tags = append(tags, aws.StringValue(f.Tag.Key)+"="+aws.StringValue(f.Tag.Value))
+			}
+		}
+	}
+	sort.Strings(tags)
+
+	parts := []string{
+		aws.StringValue(r.Status),
+		prefix,
+		strings.Join(tags, ","),
+	}
+	if v := r.AbortIncompleteMultipartUpload; v != nil {
+		parts = append(parts, fmt.Sprintf("abort:%d", aws.Int64Value(v.DaysAfterInitiation)))
+	}
+	if v := r.Expiration; v != nil {
+		parts = append(parts, fmt.Sprintf("expiration:%s/%d/%t", aws.TimeValue(v.Date).UTC().Format(time.RFC3339), aws.Int64Value(v.Days), aws.BoolValue(v.ExpiredObjectDeleteMarker)))
+	}
+	if v := r.NoncurrentVersionExpiration; v != nil {
+		parts = append(parts, fmt.Sprintf("noncurrent_expiration:%d", aws.Int64Value(v.NoncurrentDays)))
+	}
+
+	var transitions []string
+	for _, t := range r.Transitions {
+		transitions = append(transitions, fmt.Sprintf("transition:%s/%d/%s", aws.TimeValue(t.Date).UTC().Format(time.RFC3339), aws.Int64Value(t.Days), aws.StringValue(t.StorageClass)))
+	}
+	for _, t := range r.NoncurrentVersionTransitions {
+		transitions = append(transitions, fmt.Sprintf("noncurrent_transition:%d/%s", aws.Int64Value(t.NoncurrentDays), aws.StringValue(t.StorageClass)))
+	}
+	sort.Strings(transitions)
+
+	return strings.Join(append(parts, transitions...), "|")
+}
+
 func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
 	var encryptionConfiguration []map[string]interface{}
 	rules := make([]interface{}, 0, len(c.Rules))
@@ -1985,6 +2427,15 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 					rd["replica_kms_key_id"] = *v.Destination.EncryptionConfiguration.ReplicaKmsKeyID
 				}
 			}
+			if v.Destination.Account != nil {
+				rd["account_id"] = *v.Destination.Account
+			}
+			if v.Destination.AccessControlTranslation != nil {
+				rdt := map[string]interface{}{
+					"owner": aws.StringValue(v.Destination.AccessControlTranslation.Owner),
+				}
+				rd["access_control_translation"] = []interface{}{rdt}
+			}
 			t["destination"] = schema.NewSet(destinationHash, []interface{}{rd})
 		}
 
@@ -2171,6 +2622,22 @@ func destinationHash(v interface{}) int {
 	if v, ok := m["replica_kms_key_id"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
+	if v, ok := m["account_id"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("%d-", accessControlTranslationHash(v[0])))
+	}
+	return hashcode.String(buf.String())
+}
+
+func accessControlTranslationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["owner"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -89,6 +89,16 @@ func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"NoSuchConfiguration"}, func() (interface{}, error) {
+		return conn.GetBucketMetricsConfiguration(&s3.GetBucketMetricsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket metrics configuration (%s): %s", d.Id(), err)
+	}
+
 	return resourceAwsS3BucketMetricRead(d, meta)
 }
 
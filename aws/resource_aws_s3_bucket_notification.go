@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -24,6 +25,8 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsS3BucketNotificationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
 				Type:     schema.TypeString,
@@ -50,8 +53,9 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 							Optional: true,
 						},
 						"topic_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
@@ -82,8 +86,9 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 							Optional: true,
 						},
 						"queue_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArnServiceResource("sqs"),
 						},
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
@@ -114,8 +119,9 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 							Optional: true,
 						},
 						"lambda_function_arn": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 						"events": &schema.Schema{
 							Type:     schema.TypeSet,
@@ -130,6 +136,106 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 	}
 }
 
+// s3NotificationFilter is the event types and key name filter of a single
+// notification configuration.
+type s3NotificationFilter struct {
+	key    string
+	events []string
+	prefix string
+	suffix string
+}
+
+// resourceAwsS3BucketNotificationCustomizeDiff fails the plan when two
+// notification configurations share an event type and have overlapping key
+// name filters, which S3 rejects with an "overlapping suffixes" or
+// "Configurations overlap" error on apply.
+func resourceAwsS3BucketNotificationCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	changed := make(map[string]bool)
+	for _, k := range diff.GetChangedKeysPrefix("") {
+		changed[k] = true
+	}
+
+	var filters []s3NotificationFilter
+	for _, kind := range []string{"topic", "queue", "lambda_function"} {
+		for i, v := range diff.Get(kind).([]interface{}) {
+			c, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := fmt.Sprintf("%s.%d", kind, i)
+			prefix := c["filter_prefix"].(string)
+			suffix := c["filter_suffix"].(string)
+
+			// Values that aren't known until apply time read as empty and can't be checked.
+			if (prefix == "" && changed[key+".filter_prefix"]) || (suffix == "" && changed[key+".filter_suffix"]) {
+				continue
+			}
+
+			var events []string
+			for _, e := range c["events"].(*schema.Set).List() {
+				events = append(events, e.(string))
+			}
+
+			filters = append(filters, s3NotificationFilter{
+				key:    key,
+				events: events,
+				prefix: prefix,
+				suffix: suffix,
+			})
+		}
+	}
+
+	for i := 0; i < len(filters); i++ {
+		for j := i + 1; j < len(filters); j++ {
+			if s3NotificationFiltersOverlap(filters[i], filters[j]) {
+				return fmt.Errorf("%s and %s overlap: notification configurations sharing an event type "+
+					"must have non-overlapping filter_prefix or filter_suffix values", filters[i].key, filters[j].key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// s3NotificationFiltersOverlap returns whether S3 would consider the two
+// notification configurations to be overlapping: they have an event type in
+// common and an object key could match both key name filters.
+func s3NotificationFiltersOverlap(a, b s3NotificationFilter) bool {
+	if !strings.HasPrefix(a.prefix, b.prefix) && !strings.HasPrefix(b.prefix, a.prefix) {
+		return false
+	}
+	if !strings.HasSuffix(a.suffix, b.suffix) && !strings.HasSuffix(b.suffix, a.suffix) {
+		return false
+	}
+
+	for _, ae := range a.events {
+		for _, be := range b.events {
+			if s3NotificationEventsOverlap(ae, be) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// s3NotificationEventsOverlap returns whether two event types match a common
+// event, e.g. "s3:ObjectCreated:*" and "s3:ObjectCreated:Put".
+func s3NotificationEventsOverlap(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if strings.HasSuffix(a, "*") && strings.HasPrefix(b, strings.TrimSuffix(a, "*")) {
+		return true
+	}
+	if strings.HasSuffix(b, "*") && strings.HasPrefix(a, strings.TrimSuffix(b, "*")) {
+		return true
+	}
+
+	return false
+}
+
 func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 	bucket := d.Get("bucket").(string)
@@ -329,9 +435,69 @@ func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}
 
 	d.SetId(bucket)
 
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, nil, func() (interface{}, error) {
+		return s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		return s3NotificationConfigurationEqual(notificationConfiguration, out.(*s3.NotificationConfiguration))
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) notification configuration: %s", bucket, err)
+	}
+
 	return resourceAwsS3BucketNotificationRead(d, meta)
 }
 
+// s3NotificationConfigurationEqual reports whether the notification
+// configuration read from S3 matches the configuration which was put.
+// Notifications are matched by ID and compared by destination, events and
+// filter rules.
+func s3NotificationConfigurationEqual(expected, actual *s3.NotificationConfiguration) bool {
+	expectedByID := s3NotificationConfigurationKeys(expected)
+	actualByID := s3NotificationConfigurationKeys(actual)
+	if len(expectedByID) != len(actualByID) {
+		return false
+	}
+	for id, key := range expectedByID {
+		if actualByID[id] != key {
+			return false
+		}
+	}
+	return true
+}
+
+// s3NotificationConfigurationKeys returns the settings of each notification
+// in c keyed by notification ID, in a form which does not depend on the order
+// of events and filter rules.
+func s3NotificationConfigurationKeys(c *s3.NotificationConfiguration) map[string]string {
+	keys := make(map[string]string)
+	key := func(destination string, events []*string, filter *s3.NotificationConfigurationFilter) string {
+		e := aws.StringValueSlice(events)
+		sort.Strings(e)
+		var rules []string
+		if filter != nil && filter.Key != nil {
+			for _, r := range filter.Key.FilterRules {
+				// S3 returns filter rule names capitalized.
+				rules = append(rules, strings.ToLower(aws.StringValue(r.Name))+"="+aws.StringValue(r.Value))
+			}
+		}
+		sort.Strings(rules)
+		return destination + "|" + strings.Join(e, ",") + "|" + strings.Join(rules, ",")
+	}
+
+	for _, t := range c.TopicConfigurations {
+		keys[aws.StringValue(t.Id)] = key("topic:"+aws.StringValue(t.TopicArn), t.Events, t.Filter)
+	}
+	for _, q := range c.QueueConfigurations {
+		keys[aws.StringValue(q.Id)] = key("queue:"+aws.StringValue(q.QueueArn), q.Events, q.Filter)
+	}
+	for _, l := range c.LambdaFunctionConfigurations {
+		keys[aws.StringValue(l.Id)] = key("lambda:"+aws.StringValue(l.LambdaFunctionArn), l.Events, l.Filter)
+	}
+	return keys
+}
+
 func resourceAwsS3BucketNotificationDelete(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
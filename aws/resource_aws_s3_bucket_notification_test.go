@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 	"time"
@@ -144,6 +145,190 @@ func TestAccAWSS3BucketNotification_withoutFilter(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketNotification_overlappingFilters(t *testing.T) {
+	rString := acctest.RandString(8)
+
+	bucketName := fmt.Sprintf("tf-acc-bucket-notification-overlap-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketNotificationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccAWSS3BucketConfigWithOverlappingNotificationFilters(bucketName),
+				ExpectError: regexp.MustCompile(`topic.0 and queue.0 overlap`),
+			},
+		},
+	})
+}
+
+func TestS3NotificationFiltersOverlap(t *testing.T) {
+	cases := []struct {
+		a, b     s3NotificationFilter
+		expected bool
+	}{
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectCreated:Put"}},
+			expected: true,
+		},
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectRemoved:*"}},
+			expected: false,
+		},
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectRemoved:Delete"}},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectRemoved:DeleteMarkerCreated"}},
+			expected: false,
+		},
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, prefix: "images/"},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, prefix: "images/thumbnails/"},
+			expected: true,
+		},
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, prefix: "images/"},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, prefix: "logs/"},
+			expected: false,
+		},
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, suffix: ".jpg"},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}},
+			expected: true,
+		},
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, suffix: ".jpg"},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, suffix: ".png"},
+			expected: false,
+		},
+		{
+			a:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, prefix: "tf-acc-test/", suffix: ".txt"},
+			b:        s3NotificationFilter{events: []string{"s3:ObjectCreated:*"}, suffix: ".log"},
+			expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := s3NotificationFiltersOverlap(tc.a, tc.b); actual != tc.expected {
+			t.Errorf("case %d: expected %t, got %t", i, tc.expected, actual)
+		}
+		if actual := s3NotificationFiltersOverlap(tc.b, tc.a); actual != tc.expected {
+			t.Errorf("case %d (reversed): expected %t, got %t", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestS3NotificationConfigurationEqual(t *testing.T) {
+	put := &s3.NotificationConfiguration{
+		TopicConfigurations: []*s3.TopicConfiguration{
+			{
+				Id:       aws.String("topic"),
+				TopicArn: aws.String("arn:aws:sns:us-west-2:123456789012:topic"),
+				Events:   aws.StringSlice([]string{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"}),
+				Filter: &s3.NotificationConfigurationFilter{
+					Key: &s3.KeyFilter{
+						FilterRules: []*s3.FilterRule{
+							{Name: aws.String("prefix"), Value: aws.String("tf-acc-test/")},
+							{Name: aws.String("suffix"), Value: aws.String(".txt")},
+						},
+					},
+				},
+			},
+		},
+		QueueConfigurations: []*s3.QueueConfiguration{
+			{
+				Id:       aws.String("queue"),
+				QueueArn: aws.String("arn:aws:sqs:us-west-2:123456789012:queue"),
+				Events:   aws.StringSlice([]string{"s3:ObjectCreated:*"}),
+			},
+		},
+	}
+
+	cases := []struct {
+		actual   *s3.NotificationConfiguration
+		expected bool
+	}{
+		{
+			actual: &s3.NotificationConfiguration{
+				TopicConfigurations: []*s3.TopicConfiguration{
+					{
+						Id:       aws.String("topic"),
+						TopicArn: aws.String("arn:aws:sns:us-west-2:123456789012:topic"),
+						Events:   aws.StringSlice([]string{"s3:ObjectRemoved:Delete", "s3:ObjectCreated:*"}),
+						Filter: &s3.NotificationConfigurationFilter{
+							Key: &s3.KeyFilter{
+								FilterRules: []*s3.FilterRule{
+									{Name: aws.String("Suffix"), Value: aws.String(".txt")},
+									{Name: aws.String("Prefix"), Value: aws.String("tf-acc-test/")},
+								},
+							},
+						},
+					},
+				},
+				QueueConfigurations: []*s3.QueueConfiguration{
+					{
+						Id:       aws.String("queue"),
+						QueueArn: aws.String("arn:aws:sqs:us-west-2:123456789012:queue"),
+						Events:   aws.StringSlice([]string{"s3:ObjectCreated:*"}),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			// Previous configuration with the same number of notifications
+			actual: &s3.NotificationConfiguration{
+				TopicConfigurations: []*s3.TopicConfiguration{
+					{
+						Id:       aws.String("topic"),
+						TopicArn: aws.String("arn:aws:sns:us-west-2:123456789012:topic"),
+						Events:   aws.StringSlice([]string{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"}),
+					},
+				},
+				QueueConfigurations: []*s3.QueueConfiguration{
+					{
+						Id:       aws.String("queue"),
+						QueueArn: aws.String("arn:aws:sqs:us-west-2:123456789012:queue"),
+						Events:   aws.StringSlice([]string{"s3:ObjectCreated:*"}),
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			actual: &s3.NotificationConfiguration{
+				TopicConfigurations: []*s3.TopicConfiguration{
+					{
+						Id:       aws.String("other"),
+						TopicArn: aws.String("arn:aws:sns:us-west-2:123456789012:topic"),
+						Events:   aws.StringSlice([]string{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"}),
+					},
+				},
+				QueueConfigurations: []*s3.QueueConfiguration{
+					{
+						Id:       aws.String("queue"),
+						QueueArn: aws.String("arn:aws:sqs:us-west-2:123456789012:queue"),
+						Events:   aws.StringSlice([]string{"s3:ObjectCreated:*"}),
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			actual:   &s3.NotificationConfiguration{},
+			expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := s3NotificationConfigurationEqual(put, tc.actual); actual != tc.expected {
+			t.Errorf("case %d: expected %t, got %t", i, tc.expected, actual)
+		}
+	}
+}
+
 func testAccCheckAWSS3BucketNotificationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).s3conn
 
@@ -543,3 +728,25 @@ resource "aws_s3_bucket_notification" "notification" {
 }
 `, topicName, topicName, bucketName)
 }
+
+func testAccAWSS3BucketConfigWithOverlappingNotificationFilters(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+	bucket = "%s"
+}
+
+resource "aws_s3_bucket_notification" "notification" {
+	bucket = "${aws_s3_bucket.bucket.id}"
+	topic {
+		topic_arn = "arn:aws:sns:us-west-2:123456789012:example"
+		events = ["s3:ObjectCreated:*"]
+		filter_prefix = "images/"
+	}
+	queue {
+		queue_arn = "arn:aws:sqs:us-west-2:123456789012:example"
+		events = ["s3:ObjectCreated:Put"]
+		filter_prefix = "images/thumbnails/"
+	}
+}
+`, bucketName)
+}
@@ -464,6 +464,21 @@ func TestAccAWSS3BucketObject_storageClass(t *testing.T) {
 						"REDUCED_REDUNDANCY"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_storageClass(rInt, "INTELLIGENT_TIERING"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(
+						"aws_s3_bucket_object.object",
+						&obj),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object",
+						"storage_class",
+						"INTELLIGENT_TIERING"),
+					testAccCheckAWSS3BucketObjectStorageClass(
+						"aws_s3_bucket_object.object",
+						"INTELLIGENT_TIERING"),
+				),
+			},
 		},
 	})
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jen20/awspolicyequivalence"
 )
 
 func resourceAwsS3BucketPolicy() *schema.Resource {
@@ -29,7 +30,7 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     validateJsonString,
+				ValidateFunc:     validateAwsPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 		},
@@ -67,7 +68,19 @@ func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(bucket)
 
-	return nil
+	_, err = retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, []string{"NoSuchBucketPolicy"}, func() (interface{}, error) {
+		return s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
+	}, func(out interface{}) bool {
+		equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(out.(*s3.GetBucketPolicyOutput).Policy), policy)
+		return err == nil && equivalent
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 bucket (%s) policy: %s", bucket, err)
+	}
+
+	return resourceAwsS3BucketPolicyRead(d, meta)
 }
 
 func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
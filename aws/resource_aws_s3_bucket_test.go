@@ -10,6 +10,8 @@ import (
 	"testing"
 	"text/template"
 
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -795,6 +797,71 @@ func TestAccAWSS3Bucket_Replication(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_ReplicationWithAccessControlTranslation(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigReplicationWithAccessControlTranslation(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc("us-west-2", &providers)),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+					testAccCheckAWSS3BucketReplicationDestinationAttr("aws_s3_bucket.bucket", "account_id", regexp.MustCompile(`^\d{12}$`)),
+					testAccCheckAWSS3BucketReplicationDestinationAttr("aws_s3_bucket.bucket", "access_control_translation.#", regexp.MustCompile(`^1$`)),
+					testAccCheckAWSS3BucketReplicationDestinationAttr("aws_s3_bucket.bucket", "access_control_translation.0.owner", regexp.MustCompile(`^Destination$`)),
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.destination", testAccAwsRegionProviderFunc("eu-west-1", &providers)),
+					testAccCheckAWSS3BucketReplicationRules(
+						"aws_s3_bucket.bucket",
+						testAccAwsRegionProviderFunc("us-west-2", &providers),
+						[]*s3.ReplicationRule{
+							{
+								ID: aws.String("foobar"),
+								Destination: &s3.Destination{
+									Account: aws.String("${data.aws_caller_identity.current.account_id}"),
+									Bucket:  aws.String(fmt.Sprintf("arn:aws:s3:::tf-test-bucket-destination-%d", rInt)),
+									AccessControlTranslation: &s3.AccessControlTranslation{
+										Owner: aws.String(s3.OwnerOverrideDestination),
+									},
+									StorageClass: aws.String(s3.ObjectStorageClassStandard),
+								},
+								Prefix: aws.String("foo"),
+								Status: aws.String(s3.ReplicationRuleStatusEnabled),
+							},
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_ReplicationExpectAccountIdValidationError(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketConfigReplicationWithAccessControlTranslationNoAccountId(rInt),
+				ExpectError: regexp.MustCompile(`account_id must be set when access_control_translation is set`),
+			},
+		},
+	})
+}
+
 // StorageClass issue: https://github.com/hashicorp/terraform/issues/10909
 func TestAccAWSS3Bucket_ReplicationWithoutStorageClass(t *testing.T) {
 	rInt := acctest.RandInt()
@@ -837,6 +904,179 @@ func TestAccAWSS3Bucket_ReplicationExpectVersioningValidationError(t *testing.T)
 	})
 }
 
+func TestResourceAwsS3BucketCustomizeDiff(t *testing.T) {
+	rule := func(id, accountId string) map[string]interface{} {
+		destination := map[string]interface{}{
+			"bucket": "arn:aws:s3:::destination",
+			"access_control_translation": []interface{}{
+				map[string]interface{}{"owner": "Destination"},
+			},
+		}
+		if accountId != "" {
+			destination["account_id"] = accountId
+		}
+		return map[string]interface{}{
+			"id":          id,
+			"prefix":      id,
+			"status":      "Enabled",
+			"destination": []interface{}{destination},
+		}
+	}
+
+	cases := []struct {
+		rules       []interface{}
+		expectedErr string
+	}{
+		{
+			rules: []interface{}{rule("known", "123456789012")},
+		},
+		{
+			rules:       []interface{}{rule("missing", "")},
+			expectedErr: `rule "missing": account_id must be set`,
+		},
+		{
+			rules: []interface{}{rule("unknown", "${var.account_id}")},
+		},
+		{
+			rules:       []interface{}{rule("unknown", "${var.account_id}"), rule("missing", "")},
+			expectedErr: `rule "missing": account_id must be set`,
+		},
+	}
+
+	for i, tc := range cases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"bucket": "source",
+			"versioning": []interface{}{
+				map[string]interface{}{"enabled": true},
+			},
+			"replication_configuration": []interface{}{
+				map[string]interface{}{
+					"role":  "arn:aws:iam::123456789012:role/replication",
+					"rules": tc.rules,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("case %d: %s", i, err)
+		}
+		err = raw.Interpolate(map[string]ast.Variable{
+			"var.account_id": {Value: config.UnknownVariableValue, Type: ast.TypeUnknown},
+		})
+		if err != nil {
+			t.Fatalf("case %d: %s", i, err)
+		}
+
+		_, err = resourceAwsS3Bucket().Diff(nil, terraform.NewResourceConfig(raw), nil)
+		if tc.expectedErr == "" {
+			if err != nil {
+				t.Errorf("case %d: unexpected error: %s", i, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+			t.Errorf("case %d: expected error containing %q, got %v", i, tc.expectedErr, err)
+		}
+	}
+}
+
+func TestS3LifecycleRulesEqual(t *testing.T) {
+	put := []*s3.LifecycleRule{
+		{
+			ID:     aws.String("id1"),
+			Status: aws.String(s3.ExpirationStatusEnabled),
+			Filter: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					Prefix: aws.String("path1/"),
+					Tags: []*s3.Tag{
+						{Key: aws.String("a"), Value: aws.String("1")},
+						{Key: aws.String("b"), Value: aws.String("2")},
+					},
+				},
+			},
+			Expiration: &s3.LifecycleExpiration{Days: aws.Int64(365)},
+			Transitions: []*s3.Transition{
+				{Days: aws.Int64(30), StorageClass: aws.String(s3.TransitionStorageClassStandardIa)},
+				{Days: aws.Int64(60), StorageClass: aws.String(s3.TransitionStorageClassGlacier)},
+			},
+		},
+		{
+			ID:     aws.String("id2"),
+			Status: aws.String(s3.ExpirationStatusDisabled),
+			Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("path2/")},
+		},
+	}
+
+	cases := []struct {
+		actual   []*s3.LifecycleRule
+		expected bool
+	}{
+		{
+			actual: []*s3.LifecycleRule{
+				{
+					ID:     aws.String("id2"),
+					Status: aws.String(s3.ExpirationStatusDisabled),
+					Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("path2/")},
+				},
+				{
+					ID:     aws.String("id1"),
+					Status: aws.String(s3.ExpirationStatusEnabled),
+					Filter: &s3.LifecycleRuleFilter{
+						And: &s3.LifecycleRuleAndOperator{
+							Prefix: aws.String("path1/"),
+							Tags: []*s3.Tag{
+								{Key: aws.String("b"), Value: aws.String("2")},
+								{Key: aws.String("a"), Value: aws.String("1")},
+							},
+						},
+					},
+					Expiration: &s3.LifecycleExpiration{Days: aws.Int64(365)},
+					Transitions: []*s3.Transition{
+						{Days: aws.Int64(60), StorageClass: aws.String(s3.TransitionStorageClassGlacier)},
+						{Days: aws.Int64(30), StorageClass: aws.String(s3.TransitionStorageClassStandardIa)},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			// Previous rules with the same IDs
+			actual: []*s3.LifecycleRule{
+				{
+					ID:         aws.String("id1"),
+					Status:     aws.String(s3.ExpirationStatusEnabled),
+					Filter:     &s3.LifecycleRuleFilter{Prefix: aws.String("path1/")},
+					Expiration: &s3.LifecycleExpiration{Days: aws.Int64(90)},
+				},
+				{
+					ID:     aws.String("id2"),
+					Status: aws.String(s3.ExpirationStatusEnabled),
+					Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("path2/")},
+				},
+			},
+			expected: false,
+		},
+		{
+			actual: []*s3.LifecycleRule{
+				{
+					ID:     aws.String("id2"),
+					Status: aws.String(s3.ExpirationStatusDisabled),
+					Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("path2/")},
+				},
+				{
+					ID:     aws.String("id3"),
+					Status: aws.String(s3.ExpirationStatusDisabled),
+					Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("path2/")},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := s3LifecycleRulesEqual(put, tc.actual); actual != tc.expected {
+			t.Errorf("case %d: expected %t, got %t", i, tc.expected, actual)
+		}
+	}
+}
+
 func TestAWSS3BucketName(t *testing.T) {
 	validDnsNames := []string{
 		"foobar",
@@ -1220,6 +1460,10 @@ func testAccCheckAWSS3BucketReplicationRules(n string, providerF func() *schema.
 		rs, _ := s.RootModule().Resources[n]
 		for _, rule := range rules {
 			if dest := rule.Destination; dest != nil {
+				if dest.Account != nil {
+					account_id := s.RootModule().Resources["data.aws_caller_identity.current"].Primary.Attributes["account_id"]
+					dest.Account = aws.String(strings.Replace(*dest.Account, "${data.aws_caller_identity.current.account_id}", account_id, -1))
+				}
 				if ec := dest.EncryptionConfiguration; ec != nil {
 					if ec.ReplicaKmsKeyID != nil {
 						key_arn := s.RootModule().Resources["aws_kms_key.replica"].Primary.Attributes["arn"]
@@ -1252,6 +1496,35 @@ func testAccCheckAWSS3BucketReplicationRules(n string, providerF func() *schema.
 	}
 }
 
+// testAccCheckAWSS3BucketReplicationDestinationAttr checks that an attribute
+// of every replication destination in state matches the given expression.
+// Rules and destinations are sets, so their state keys contain hashes.
+func testAccCheckAWSS3BucketReplicationDestinationAttr(n, key string, re *regexp.Regexp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		keyRe := regexp.MustCompile(`^replication_configuration\.0\.rules\.\d+\.destination\.\d+\.` + regexp.QuoteMeta(key) + `$`)
+		found := false
+		for k, v := range rs.Primary.Attributes {
+			if !keyRe.MatchString(k) {
+				continue
+			}
+			found = true
+			if !re.MatchString(v) {
+				return fmt.Errorf("%s: Attribute %q didn't match %q, got %q", n, k, re.String(), v)
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: No replication destination attribute %q found", n, key)
+		}
+
+		return nil
+	}
+}
+
 // These need a bit of randomness as the name can only be used once globally
 // within AWS
 func testAccBucketName(randInt int) string {
@@ -1853,6 +2126,93 @@ resource "aws_s3_bucket" "destination" {
 `, randInt, randInt, randInt)
 }
 
+func testAccAWSS3BucketConfigReplicationWithAccessControlTranslation(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "bucket" {
+    provider = "aws.uswest2"
+    bucket   = "tf-test-bucket-%d"
+    acl      = "private"
+
+    versioning {
+        enabled = true
+    }
+
+    replication_configuration {
+        role = "${aws_iam_role.role.arn}"
+        rules {
+            id     = "foobar"
+            prefix = "foo"
+            status = "Enabled"
+
+            destination {
+                account_id    = "${data.aws_caller_identity.current.account_id}"
+                bucket        = "${aws_s3_bucket.destination.arn}"
+                storage_class = "STANDARD"
+
+                access_control_translation {
+                    owner = "Destination"
+                }
+            }
+        }
+    }
+}
+
+resource "aws_s3_bucket" "destination" {
+    provider = "aws.euwest"
+    bucket   = "tf-test-bucket-destination-%d"
+    region   = "eu-west-1"
+
+    versioning {
+        enabled = true
+    }
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationWithAccessControlTranslationNoAccountId(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+    provider = "aws.uswest2"
+    bucket   = "tf-test-bucket-%d"
+    acl      = "private"
+
+    versioning {
+        enabled = true
+    }
+
+    replication_configuration {
+        role = "${aws_iam_role.role.arn}"
+        rules {
+            id     = "foobar"
+            prefix = "foo"
+            status = "Enabled"
+
+            destination {
+                bucket        = "${aws_s3_bucket.destination.arn}"
+                storage_class = "STANDARD"
+
+                access_control_translation {
+                    owner = "Destination"
+                }
+            }
+        }
+    }
+}
+
+resource "aws_s3_bucket" "destination" {
+    provider = "aws.euwest"
+    bucket   = "tf-test-bucket-destination-%d"
+    region   = "eu-west-1"
+
+    versioning {
+        enabled = true
+    }
+}
+`, randInt, randInt, randInt)
+}
+
 func testAccAWSS3BucketConfigReplicationWithSseKmsEncryptedObjects(randInt int) string {
 	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
 resource "aws_kms_key" "replica" {
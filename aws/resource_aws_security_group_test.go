@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -22,6 +23,14 @@ func init() {
 	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		F:    testSweepSecurityGroups,
+		Dependencies: []string{
+			"aws_autoscaling_group",
+			"aws_db_instance",
+			"aws_elasticsearch_domain",
+			"aws_elb",
+			"aws_mq_broker",
+			"aws_redshift_cluster",
+		},
 	})
 }
 
@@ -32,6 +41,7 @@ func testSweepSecurityGroups(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	var securityGroups []*ec2.SecurityGroup
 	req := &ec2.DescribeSecurityGroupsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -40,14 +50,34 @@ func testSweepSecurityGroups(region string) error {
 			},
 		},
 	}
-	resp, err := conn.DescribeSecurityGroups(req)
+	for {
+		resp, err := conn.DescribeSecurityGroups(req)
+		if err != nil {
+			return fmt.Errorf("Error describing Security Groups: %s", err)
+		}
+		securityGroups = append(securityGroups, resp.SecurityGroups...)
+
+		if resp.NextToken == nil {
+			break
+		}
+		req.NextToken = resp.NextToken
+	}
 
-	if len(resp.SecurityGroups) == 0 {
+	if len(securityGroups) == 0 {
 		log.Print("[DEBUG] No aws security groups to sweep")
 		return nil
 	}
 
-	for _, sg := range resp.SecurityGroups {
+	if testSweepDryRun() {
+		for _, sg := range securityGroups {
+			log.Printf("[INFO] Would revoke the rules of and delete Security Group (%s)", *sg.GroupId)
+		}
+		return nil
+	}
+
+	// Revoke the rules of every group first, as they may refer to each other.
+	var errors error
+	for _, sg := range securityGroups {
 		// revoke the rules
 		if sg.IpPermissions != nil {
 			req := &ec2.RevokeSecurityGroupIngressInput{
@@ -56,9 +86,9 @@ func testSweepSecurityGroups(region string) error {
 			}
 
 			if _, err = conn.RevokeSecurityGroupIngress(req); err != nil {
-				return fmt.Errorf(
-					"Error revoking default egress rule for Security Group (%s): %s",
-					*sg.GroupId, err)
+				errors = multierror.Append(errors, fmt.Errorf(
+					"Error revoking default ingress rule for Security Group (%s): %s",
+					*sg.GroupId, err))
 			}
 		}
 
@@ -69,26 +99,27 @@ func testSweepSecurityGroups(region string) error {
 			}
 
 			if _, err = conn.RevokeSecurityGroupEgress(req); err != nil {
-				return fmt.Errorf(
+				errors = multierror.Append(errors, fmt.Errorf(
 					"Error revoking default egress rule for Security Group (%s): %s",
-					*sg.GroupId, err)
+					*sg.GroupId, err))
 			}
 		}
 	}
 
-	for _, sg := range resp.SecurityGroups {
+	for _, sg := range securityGroups {
+		log.Printf("[INFO] Deleting Security Group (%s)", *sg.GroupId)
 		// delete the group
 		_, err := conn.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
 			GroupId: sg.GroupId,
 		})
 		if err != nil {
-			return fmt.Errorf(
+			errors = multierror.Append(errors, fmt.Errorf(
 				"Error deleting Security Group (%s): %s",
-				*sg.GroupId, err)
+				*sg.GroupId, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestProtocolStateFunc(t *testing.T) {
@@ -15,6 +15,9 @@ func resourceAwsSesActiveReceiptRuleSet() *schema.Resource {
 		Update: resourceAwsSesActiveReceiptRuleSetUpdate,
 		Read:   resourceAwsSesActiveReceiptRuleSetRead,
 		Delete: resourceAwsSesActiveReceiptRuleSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSesActiveReceiptRuleSetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rule_set_name": &schema.Schema{
@@ -78,3 +81,22 @@ func resourceAwsSesActiveReceiptRuleSetDelete(d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+func resourceAwsSesActiveReceiptRuleSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).sesConn
+
+	response, err := conn.DescribeActiveReceiptRuleSet(&ses.DescribeActiveReceiptRuleSetInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error reading active SES rule set: %s", err)
+	}
+
+	if response.Metadata == nil {
+		return nil, fmt.Errorf("error importing active SES rule set (%s): no receipt rule set is active", d.Id())
+	}
+
+	if name := aws.StringValue(response.Metadata.Name); name != d.Id() {
+		return nil, fmt.Errorf("error importing active SES rule set (%s): the active receipt rule set is %q", d.Id(), name)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ses"
@@ -23,6 +24,18 @@ func TestAccAWSSESActiveReceiptRuleSet_basic(t *testing.T) {
 					testAccCheckAwsSESActiveReceiptRuleSetExists("aws_ses_active_receipt_rule_set.test"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_ses_active_receipt_rule_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Ensure only the active rule set can be imported
+			resource.TestStep{
+				ResourceName:  "aws_ses_active_receipt_rule_set.test",
+				ImportState:   true,
+				ImportStateId: "not-the-active-rule-set",
+				ExpectError:   regexp.MustCompile(`the active receipt rule set is`),
+			},
 		},
 	})
 }
@@ -121,8 +121,9 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 						},
 
 						"topic_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 
 						"position": {
@@ -158,8 +159,9 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"function_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 
 						"invocation_type": {
@@ -169,8 +171,9 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 						},
 
 						"topic_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 
 						"position": {
@@ -211,7 +214,7 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 						"kms_key_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validateArn,
+							ValidateFunc: validateKmsKeyArn,
 						},
 
 						"object_key_prefix": {
@@ -220,8 +223,9 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 						},
 
 						"topic_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 
 						"position": {
@@ -259,8 +263,9 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"topic_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 
 						"position": {
@@ -290,8 +295,9 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 						},
 
 						"topic_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 
 						"position": {
@@ -321,13 +327,15 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"organization_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArnServiceResource("workmail", "organization/"),
 						},
 
 						"topic_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSnsTopicArn,
 						},
 
 						"position": {
@@ -2,6 +2,8 @@ package aws
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,6 +18,9 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 		Create: resourceAwsSnapshotCreateVolumePermissionCreate,
 		Read:   resourceAwsSnapshotCreateVolumePermissionRead,
 		Delete: resourceAwsSnapshotCreateVolumePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSnapshotCreateVolumePermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"snapshot_id": &schema.Schema{
@@ -79,7 +84,35 @@ func resourceAwsSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, met
 	return nil
 }
 
+func resourceAwsSnapshotCreateVolumePermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The ID is "<snapshot_id>-<account_id>"; account IDs never contain a hyphen.
+	idx := strings.LastIndex(d.Id(), "-")
+	if idx <= 0 || idx == len(d.Id())-1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SNAPSHOT_ID-ACCOUNT_ID", d.Id())
+	}
+
+	d.Set("snapshot_id", d.Id()[:idx])
+	d.Set("account_id", d.Id()[idx+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	snapshot_id := d.Get("snapshot_id").(string)
+	account_id := d.Get("account_id").(string)
+
+	exists, err := hasCreateVolumePermission(conn, snapshot_id, account_id)
+	if err != nil {
+		return fmt.Errorf("Error reading snapshot createVolumePermission (%s): %s", d.Id(), err)
+	}
+	if !exists {
+		log.Printf("[WARN] Snapshot createVolumePermission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	return nil
 }
 
@@ -121,15 +154,26 @@ func resourceAwsSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, met
 }
 
 func hasCreateVolumePermission(conn *ec2.EC2, snapshot_id string, account_id string) (bool, error) {
-	_, state, err := resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn, snapshot_id, account_id)()
+	attrs, err := conn.DescribeSnapshotAttribute(&ec2.DescribeSnapshotAttributeInput{
+		SnapshotId: aws.String(snapshot_id),
+		Attribute:  aws.String("createVolumePermission"),
+	})
 	if err != nil {
+		// When a snapshot disappears out from under a permission resource, we
+		// drop the permission from the state.
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			log.Printf("[DEBUG] %s no longer exists, so we'll drop createVolumePermission for %s from the state", snapshot_id, account_id)
+			return false, nil
+		}
 		return false, err
 	}
-	if state == "granted" {
-		return true, nil
-	} else {
-		return false, nil
+
+	for _, vp := range attrs.CreateVolumePermissions {
+		if aws.StringValue(vp.UserId) == account_id {
+			return true, nil
+		}
 	}
+	return false, nil
 }
 
 func resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn *ec2.EC2, snapshot_id string, account_id string) resource.StateRefreshFunc {
@@ -143,7 +187,7 @@ func resourceAwsSnapshotCreateVolumePermissionStateRefreshFunc(conn *ec2.EC2, sn
 		}
 
 		for _, vp := range attrs.CreateVolumePermissions {
-			if *vp.UserId == account_id {
+			if aws.StringValue(vp.UserId) == account_id {
 				return attrs, "granted", nil
 			}
 		}
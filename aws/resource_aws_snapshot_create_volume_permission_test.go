@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -23,6 +24,11 @@ func TestAccAWSSnapshotCreateVolumePermission_Basic(t *testing.T) {
 					testAccAWSSnapshotCreateVolumePermissionExists(&accountId, &snapshotId),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_snapshot_create_volume_permission.self-test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Drop just create volume permission to test destruction
 			resource.TestStep{
 				Config: testAccAWSSnapshotCreateVolumePermissionConfig(false),
@@ -30,6 +36,15 @@ func TestAccAWSSnapshotCreateVolumePermission_Basic(t *testing.T) {
 					testAccAWSSnapshotCreateVolumePermissionDestroyed(&accountId, &snapshotId),
 				),
 			},
+			// Importing the now missing create volume permission must fail
+			resource.TestStep{
+				ResourceName: "aws_snapshot_create_volume_permission.self-test",
+				ImportState:  true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("%s-%s", snapshotId, accountId), nil
+				},
+				ExpectError: regexp.MustCompile(`You cannot import non-existent\s+resources`),
+			},
 		},
 	})
 }
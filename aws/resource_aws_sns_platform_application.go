@@ -67,24 +67,29 @@ func resourceAwsSnsPlatformApplication() *schema.Resource {
 				Computed: true,
 			},
 			"event_delivery_failure_topic_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"event_endpoint_created_topic_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"event_endpoint_deleted_topic_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"event_endpoint_updated_topic_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"failure_feedback_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"platform_principal": {
 				Type:      schema.TypeString,
@@ -92,8 +97,9 @@ func resourceAwsSnsPlatformApplication() *schema.Resource {
 				StateFunc: hashSum,
 			},
 			"success_feedback_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"success_feedback_sample_rate": {
 				Type:     schema.TypeString,
@@ -70,9 +70,10 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 				Default:  1,
 			},
 			"topic_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"delivery_policy": {
 				Type:     schema.TypeString,
@@ -35,7 +35,7 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"replace_unhealthy_instances": {
 				Type:     schema.TypeBool,
@@ -332,8 +332,11 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateLbTargetGroupArn,
+				},
+				Set: schema.HashString,
 			},
 		},
 	}
@@ -658,27 +661,15 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 
 	// Since IAM is eventually consistent, we retry creation as a newly created role may not
 	// take effect immediately, resulting in an InvalidSpotFleetRequestConfig error
-	var resp *ec2.RequestSpotFleetOutput
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = conn.RequestSpotFleet(spotFleetOpts)
-
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				// IAM is eventually consistent :/
-				if awsErr.Code() == "InvalidSpotFleetRequestConfig" {
-					return resource.RetryableError(
-						fmt.Errorf("[WARN] Error creating Spot fleet request, retrying: %s", err))
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	raw, err := retryOnIamPropagation(10*time.Minute, []awsErrMatcher{
+		{"InvalidSpotFleetRequestConfig", ""},
+	}, func() (interface{}, error) {
+		return conn.RequestSpotFleet(spotFleetOpts)
 	})
-
 	if err != nil {
 		return fmt.Errorf("Error requesting spot fleet: %s", err)
 	}
+	resp := raw.(*ec2.RequestSpotFleetOutput)
 
 	d.SetId(*resp.SpotFleetRequestId)
 
@@ -33,8 +33,9 @@ func resourceAwsSsmResourceDataSync() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"kms_key_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateKmsKeyArn,
 						},
 						"bucket_name": {
 							Type:     schema.TypeString,
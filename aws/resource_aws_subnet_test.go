@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -19,7 +20,13 @@ func init() {
 		F:    testSweepSubnets,
 		Dependencies: []string{
 			"aws_batch_compute_environment",
+			"aws_dax_cluster",
+			"aws_db_instance",
+			"aws_elasticsearch_domain",
 			"aws_elb",
+			"aws_mq_broker",
+			"aws_nat_gateway",
+			"aws_redshift_cluster",
 		},
 	})
 }
@@ -31,6 +38,7 @@ func testSweepSubnets(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	// DescribeSubnets returns every matching subnet, it isn't paginated.
 	req := &ec2.DescribeSubnetsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -51,19 +59,26 @@ func testSweepSubnets(region string) error {
 		return nil
 	}
 
+	var errors error
 	for _, subnet := range resp.Subnets {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete Subnet (%s)", *subnet.SubnetId)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting Subnet (%s)", *subnet.SubnetId)
 		// delete the subnet
 		_, err := conn.DeleteSubnet(&ec2.DeleteSubnetInput{
 			SubnetId: subnet.SubnetId,
 		})
 		if err != nil {
-			return fmt.Errorf(
+			errors = multierror.Append(errors, fmt.Errorf(
 				"Error deleting Subnet (%s): %s",
-				*subnet.SubnetId, err)
+				*subnet.SubnetId, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSSubnet_basic(t *testing.T) {
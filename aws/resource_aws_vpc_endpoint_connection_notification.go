@@ -35,7 +35,7 @@ func resourceAwsVpcEndpointConnectionNotification() *schema.Resource {
 			"connection_notification_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validateArn,
+				ValidateFunc: validateSnsTopicArn,
 			},
 			"connection_events": {
 				Type:     schema.TypeSet,
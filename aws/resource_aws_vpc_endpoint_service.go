@@ -31,8 +31,11 @@ func resourceAwsVpcEndpointService() *schema.Resource {
 				Type:     schema.TypeSet,
 				Required: true,
 				MinItems: 1,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArnServiceResource("elasticloadbalancing", "loadbalancer/net/"),
+				},
+				Set: schema.HashString,
 			},
 			"allowed_principals": {
 				Type:     schema.TypeSet,
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,6 +25,8 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsVpcPeeringConnectionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"peer_owner_id": {
 				Type:     schema.TypeString,
@@ -143,7 +146,7 @@ func resourceAwsVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Account ID %s, VPC PeerConn Requester %s, Accepter %s",
 		client.accountid, *pc.RequesterVpcInfo.OwnerId, *pc.AccepterVpcInfo.OwnerId)
 
-	if (client.accountid == *pc.AccepterVpcInfo.OwnerId) && (client.accountid != *pc.RequesterVpcInfo.OwnerId) {
+	if vpcPeeringConnectionIsAccepter(client, pc) {
 		// We're the accepter
 		d.Set("peer_owner_id", pc.RequesterVpcInfo.OwnerId)
 		d.Set("peer_vpc_id", pc.RequesterVpcInfo.VpcId)
@@ -199,27 +202,37 @@ func resourceVPCPeeringConnectionAccept(conn *ec2.EC2, id string) (string, error
 	return *pc.Status.Code, nil
 }
 
-func resourceVPCPeeringConnectionOptionsModify(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringConnectionOptionsModify(d *schema.ResourceData, meta interface{}, pc *ec2.VpcPeeringConnection) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	modifyOpts := &ec2.ModifyVpcPeeringConnectionOptionsInput{
-		VpcPeeringConnectionId: aws.String(d.Id()),
+		VpcPeeringConnectionId: pc.VpcPeeringConnectionId,
 	}
 
-	if v, ok := d.GetOk("accepter"); ok {
-		if s := v.(*schema.Set); len(s.List()) > 0 {
+	// ClassicLink options can't be set on inter-region peering connections.
+	crossRegionPeering := aws.StringValue(pc.RequesterVpcInfo.Region) != aws.StringValue(pc.AccepterVpcInfo.Region)
+
+	// Only send the options that have changed, as each side of a
+	// cross-account or inter-region peering connection may only
+	// modify its own options.
+	if d.HasChange("accepter") {
+		if s := d.Get("accepter").(*schema.Set); len(s.List()) > 0 {
 			co := s.List()[0].(map[string]interface{})
-			modifyOpts.AccepterPeeringConnectionOptions = expandPeeringOptions(co)
+			modifyOpts.AccepterPeeringConnectionOptions = expandPeeringOptions(co, crossRegionPeering)
 		}
 	}
 
-	if v, ok := d.GetOk("requester"); ok {
-		if s := v.(*schema.Set); len(s.List()) > 0 {
+	if d.HasChange("requester") {
+		if s := d.Get("requester").(*schema.Set); len(s.List()) > 0 {
 			co := s.List()[0].(map[string]interface{})
-			modifyOpts.RequesterPeeringConnectionOptions = expandPeeringOptions(co)
+			modifyOpts.RequesterPeeringConnectionOptions = expandPeeringOptions(co, crossRegionPeering)
 		}
 	}
 
+	if modifyOpts.AccepterPeeringConnectionOptions == nil && modifyOpts.RequesterPeeringConnectionOptions == nil {
+		return nil
+	}
+
 	log.Printf("[DEBUG] VPC Peering Connection modify options: %#v", modifyOpts)
 	if _, err := conn.ModifyVpcPeeringConnectionOptions(modifyOpts); err != nil {
 		return err
@@ -266,7 +279,7 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 				"or activate VPC Peering Connection manually.", d.Id())
 		}
 
-		if err := resourceVPCPeeringConnectionOptionsModify(d, meta); err != nil {
+		if err := resourceVPCPeeringConnectionOptionsModify(d, meta, pc); err != nil {
 			return errwrap.Wrapf("Error modifying VPC Peering Connection options: {{err}}", err)
 		}
 	}
@@ -392,13 +405,17 @@ func flattenPeeringOptions(options *ec2.VpcPeeringConnectionOptionsDescription)
 	return
 }
 
-func expandPeeringOptions(m map[string]interface{}) *ec2.PeeringConnectionOptionsRequest {
+func expandPeeringOptions(m map[string]interface{}, crossRegionPeering bool) *ec2.PeeringConnectionOptionsRequest {
 	r := &ec2.PeeringConnectionOptionsRequest{}
 
 	if v, ok := m["allow_remote_vpc_dns_resolution"]; ok {
 		r.AllowDnsResolutionFromRemoteVpc = aws.Bool(v.(bool))
 	}
 
+	if crossRegionPeering {
+		return r
+	}
+
 	if v, ok := m["allow_classic_link_to_remote_vpc"]; ok {
 		r.AllowEgressFromLocalClassicLinkToRemoteVpc = aws.Bool(v.(bool))
 	}
@@ -410,6 +427,90 @@ func expandPeeringOptions(m map[string]interface{}) *ec2.PeeringConnectionOption
 	return r
 }
 
+// resourceAwsVpcPeeringConnectionCustomizeDiff fails the plan when ClassicLink
+// options are enabled on an inter-region VPC Peering Connection, as they would
+// otherwise never be applied and show a permanent diff.
+func resourceAwsVpcPeeringConnectionCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	keys := vpcPeeringConnectionClassicLinkOptionsEnabled(diff)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	peerRegion := diff.Get("peer_region").(string)
+	if peerRegion == "" || peerRegion == meta.(*AWSClient).region {
+		return nil
+	}
+
+	return vpcPeeringConnectionClassicLinkOptionsError(keys)
+}
+
+// resourceAwsVpcPeeringConnectionOptionsCustomizeDiff is the equivalent of
+// resourceAwsVpcPeeringConnectionCustomizeDiff for resources which manage the
+// options of an existing VPC Peering Connection. To avoid calling EC2 on every
+// plan, the connection is only looked up when the options change, ClassicLink
+// options are enabled and the connection ID is known.
+func resourceAwsVpcPeeringConnectionOptionsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("accepter") && !diff.HasChange("requester") {
+		return nil
+	}
+
+	keys := vpcPeeringConnectionClassicLinkOptionsEnabled(diff)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	// An ID which is not known until apply reads as empty.
+	id := diff.Get("vpc_peering_connection_id").(string)
+	if id == "" {
+		return nil
+	}
+
+	pcRaw, _, err := resourceAwsVPCPeeringConnectionStateRefreshFunc(meta.(*AWSClient).ec2conn, id)()
+	if err != nil || pcRaw == nil {
+		// Leave any error to be reported at apply time.
+		return nil
+	}
+	pc := pcRaw.(*ec2.VpcPeeringConnection)
+
+	if aws.StringValue(pc.RequesterVpcInfo.Region) == aws.StringValue(pc.AccepterVpcInfo.Region) {
+		return nil
+	}
+
+	return vpcPeeringConnectionClassicLinkOptionsError(keys)
+}
+
+// vpcPeeringConnectionClassicLinkOptionsEnabled returns the keys of the
+// ClassicLink options enabled in the accepter and requester blocks.
+func vpcPeeringConnectionClassicLinkOptionsEnabled(diff *schema.ResourceDiff) []string {
+	var keys []string
+	for _, side := range []string{"accepter", "requester"} {
+		for _, raw := range diff.Get(side).(*schema.Set).List() {
+			m := raw.(map[string]interface{})
+			for _, option := range []string{"allow_classic_link_to_remote_vpc", "allow_vpc_to_remote_classic_link"} {
+				if v, ok := m[option].(bool); ok && v {
+					keys = append(keys, fmt.Sprintf("%s.%s", side, option))
+				}
+			}
+		}
+	}
+	return keys
+}
+
+func vpcPeeringConnectionClassicLinkOptionsError(keys []string) error {
+	return fmt.Errorf("%s: ClassicLink options are not supported for inter-region VPC Peering Connections", strings.Join(keys, ", "))
+}
+
+// vpcPeeringConnectionIsAccepter returns whether the provider's account and
+// region are those of the accepter's side of the VPC Peering Connection.
+func vpcPeeringConnectionIsAccepter(client *AWSClient, pc *ec2.VpcPeeringConnection) bool {
+	isRequester := client.accountid == aws.StringValue(pc.RequesterVpcInfo.OwnerId) &&
+		client.region == aws.StringValue(pc.RequesterVpcInfo.Region)
+	isAccepter := client.accountid == aws.StringValue(pc.AccepterVpcInfo.OwnerId) &&
+		client.region == aws.StringValue(pc.AccepterVpcInfo.Region)
+
+	return isAccepter && !isRequester
+}
+
 func checkVpcPeeringConnectionAvailable(conn *ec2.EC2, id string) error {
 	// Wait for the vpc peering connection to become available
 	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become available.", id)
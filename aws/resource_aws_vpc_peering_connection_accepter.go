@@ -14,6 +14,15 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 		Read:   resourceAwsVPCPeeringRead,
 		Update: resourceAwsVPCPeeringUpdate,
 		Delete: resourceAwsVPCPeeringAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("vpc_peering_connection_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: resourceAwsVpcPeeringConnectionOptionsCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_peering_connection_id": &schema.Schema{
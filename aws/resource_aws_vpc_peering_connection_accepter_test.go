@@ -28,6 +28,14 @@ func TestAccAWSVPCPeeringConnectionAccepter_sameRegion(t *testing.T) {
 						"accept_status", "active"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_vpc_peering_connection_accepter.peer",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_accept",
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcPeeringConnectionOptions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcPeeringConnectionOptionsCreate,
+		Read:   resourceAwsVpcPeeringConnectionOptionsRead,
+		Update: resourceAwsVpcPeeringConnectionOptionsUpdate,
+		Delete: resourceAwsVpcPeeringConnectionOptionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsVpcPeeringConnectionOptionsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"accepter":  vpcPeeringConnectionOptionsSchema(),
+			"requester": vpcPeeringConnectionOptionsSchema(),
+		},
+	}
+}
+
+func resourceAwsVpcPeeringConnectionOptionsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("vpc_peering_connection_id").(string))
+
+	return resourceAwsVpcPeeringConnectionOptionsUpdate(d, meta)
+}
+
+func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	pcRaw, _, err := resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return errwrap.Wrapf("Error reading VPC Peering Connection: {{err}}", err)
+	}
+
+	if pcRaw == nil {
+		log.Printf("[WARN] VPC Peering Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	pc := pcRaw.(*ec2.VpcPeeringConnection)
+
+	d.Set("vpc_peering_connection_id", pc.VpcPeeringConnectionId)
+
+	if pc.AccepterVpcInfo.PeeringOptions != nil {
+		err := d.Set("accepter", flattenPeeringOptions(pc.AccepterVpcInfo.PeeringOptions))
+		if err != nil {
+			return errwrap.Wrapf("Error setting VPC Peering Connection accepter information: {{err}}", err)
+		}
+	}
+
+	if pc.RequesterVpcInfo.PeeringOptions != nil {
+		err := d.Set("requester", flattenPeeringOptions(pc.RequesterVpcInfo.PeeringOptions))
+		if err != nil {
+			return errwrap.Wrapf("Error setting VPC Peering Connection requester information: {{err}}", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	pcRaw, _, err := resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return errwrap.Wrapf("Error reading VPC Peering Connection: {{err}}", err)
+	}
+
+	if pcRaw == nil {
+		return fmt.Errorf("VPC Peering Connection %q not found", d.Id())
+	}
+
+	pc := pcRaw.(*ec2.VpcPeeringConnection)
+
+	if aws.StringValue(pc.Status.Code) != ec2.VpcPeeringConnectionStateReasonCodeActive {
+		return fmt.Errorf("Unable to modify peering options. The VPC Peering Connection "+
+			"%q is not active.", d.Id())
+	}
+
+	if err := resourceVPCPeeringConnectionOptionsModify(d, meta, pc); err != nil {
+		return errwrap.Wrapf("Error modifying VPC Peering Connection options: {{err}}", err)
+	}
+
+	return resourceAwsVpcPeeringConnectionOptionsRead(d, meta)
+}
+
+func resourceAwsVpcPeeringConnectionOptionsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Will not reset VPC Peering Connection options. Terraform will remove this resource from the state file, however the options will remain.")
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSVpcPeeringConnectionOptions_basic(t *testing.T) {
+	var connection ec2.VpcPeeringConnection
+	resourceName := "aws_vpc_peering_connection_options.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcPeeringConnectionOptionsConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcPeeringConnectionExists("aws_vpc_peering_connection.test", &connection),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", "aws_vpc_peering_connection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "accepter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester.#", "1"),
+					testAccCheckAWSVpcPeeringConnectionOptions(
+						"aws_vpc_peering_connection.test", "accepter",
+						&ec2.VpcPeeringConnectionOptionsDescription{
+							AllowDnsResolutionFromRemoteVpc:            aws.Bool(true),
+							AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+							AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+						},
+					),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcPeeringConnectionOptionsConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcPeeringConnectionExists("aws_vpc_peering_connection.test", &connection),
+					testAccCheckAWSVpcPeeringConnectionOptions(
+						"aws_vpc_peering_connection.test", "accepter",
+						&ec2.VpcPeeringConnectionOptionsDescription{
+							AllowDnsResolutionFromRemoteVpc:            aws.Bool(false),
+							AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+							AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcPeeringConnectionOptionsConfig(rName string, dnsResolution bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = "${aws_vpc.test.id}"
+  peer_vpc_id = "${aws_vpc.peer.id}"
+  auto_accept = true
+
+  tags {
+    Name = "%[1]s"
+  }
+
+  lifecycle {
+    ignore_changes = ["accepter", "requester"]
+  }
+}
+
+resource "aws_vpc_peering_connection_options" "test" {
+  vpc_peering_connection_id = "${aws_vpc_peering_connection.test.id}"
+
+  accepter {
+    allow_remote_vpc_dns_resolution = %[2]t
+  }
+
+  requester {
+    allow_vpc_to_remote_classic_link = false
+    allow_classic_link_to_remote_vpc = false
+  }
+}
+`, rName, dnsResolution)
+}
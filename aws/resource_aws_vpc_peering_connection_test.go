@@ -356,6 +356,22 @@ func TestAccAWSVPCPeeringConnection_region(t *testing.T) {
 	})
 }
 
+func TestAccAWSVPCPeeringConnection_regionClassicLinkOptions(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccVpcPeeringConfigRegionClassicLinkOptions,
+				ExpectError: regexp.MustCompile(`requester.allow_vpc_to_remote_classic_link: ClassicLink options are not supported for inter-region VPC Peering Connections`),
+			},
+		},
+	})
+}
+
 const testAccVpcPeeringConfig = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.0.0.0/16"
@@ -526,3 +542,42 @@ resource "aws_vpc_peering_connection" "foo" {
 	peer_region = "us-east-1"
 }
 `
+
+const testAccVpcPeeringConfigRegionClassicLinkOptions = `
+provider "aws" {
+  alias  = "main"
+  region = "us-west-2"
+}
+
+provider "aws" {
+  alias  = "peer"
+  region = "us-east-1"
+}
+
+resource "aws_vpc" "foo" {
+  provider   = "aws.main"
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-vpc-peering-conn-region-classic-link-foo"
+  }
+}
+
+resource "aws_vpc" "bar" {
+  provider   = "aws.peer"
+  cidr_block = "10.1.0.0/16"
+  tags {
+    Name = "terraform-testacc-vpc-peering-conn-region-classic-link-bar"
+  }
+}
+
+resource "aws_vpc_peering_connection" "foo" {
+  provider    = "aws.main"
+  vpc_id      = "${aws_vpc.foo.id}"
+  peer_vpc_id = "${aws_vpc.bar.id}"
+  peer_region = "us-east-1"
+
+  requester {
+    allow_vpc_to_remote_classic_link = true
+  }
+}
+`
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -35,6 +36,7 @@ func testSweepVPCs(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	// DescribeVpcs returns every matching VPC, it isn't paginated.
 	req := &ec2.DescribeVpcsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -55,19 +57,26 @@ func testSweepVPCs(region string) error {
 		return nil
 	}
 
+	var errors error
 	for _, vpc := range resp.Vpcs {
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete VPC (%s)", *vpc.VpcId)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting VPC (%s)", *vpc.VpcId)
 		// delete the vpc
 		_, err := conn.DeleteVpc(&ec2.DeleteVpcInput{
 			VpcId: vpc.VpcId,
 		})
 		if err != nil {
-			return fmt.Errorf(
+			errors = multierror.Append(errors, fmt.Errorf(
 				"Error deleting VPC (%s): %s",
-				*vpc.VpcId, err)
+				*vpc.VpcId, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSVpc_basic(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -28,6 +29,7 @@ func testSweepVPNGateways(region string) error {
 	}
 	conn := client.(*AWSClient).ec2conn
 
+	// DescribeVpnGateways returns every matching gateway, it isn't paginated.
 	req := &ec2.DescribeVpnGatewaysInput{
 		Filters: []*ec2.Filter{
 			{
@@ -48,18 +50,61 @@ func testSweepVPNGateways(region string) error {
 		return nil
 	}
 
+	var errors error
 	for _, vpng := range resp.VpnGateways {
+		if *vpng.State == ec2.VpnStateDeleted {
+			continue
+		}
+
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete VPN Gateway (%s)", *vpng.VpnGatewayId)
+			continue
+		}
+
+		// An attached gateway can't be deleted.
+		detached := true
+		for _, attachment := range vpng.VpcAttachments {
+			if *attachment.State == ec2.AttachmentStatusDetached {
+				continue
+			}
+
+			log.Printf("[INFO] Detaching VPN Gateway (%s) from VPC (%s)", *vpng.VpnGatewayId, *attachment.VpcId)
+			_, err := conn.DetachVpnGateway(&ec2.DetachVpnGatewayInput{
+				VpnGatewayId: vpng.VpnGatewayId,
+				VpcId:        attachment.VpcId,
+			})
+			if err == nil {
+				stateConf := &resource.StateChangeConf{
+					Pending: []string{"attached", "detaching", "available"},
+					Target:  []string{"detached"},
+					Refresh: vpnGatewayAttachStateRefreshFunc(conn, *vpng.VpnGatewayId, "detached"),
+					Timeout: 10 * time.Minute,
+				}
+				_, err = stateConf.WaitForState()
+			}
+			if err != nil {
+				errors = multierror.Append(errors, fmt.Errorf(
+					"Error detaching VPN Gateway (%s) from VPC (%s): %s",
+					*vpng.VpnGatewayId, *attachment.VpcId, err))
+				detached = false
+			}
+		}
+		if !detached {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting VPN Gateway (%s)", *vpng.VpnGatewayId)
 		_, err := conn.DeleteVpnGateway(&ec2.DeleteVpnGatewayInput{
 			VpnGatewayId: vpng.VpnGatewayId,
 		})
 		if err != nil {
-			return fmt.Errorf(
+			errors = multierror.Append(errors, fmt.Errorf(
 				"Error deleting VPN Gateway (%s): %s",
-				*vpng.VpnGatewayId, err)
+				*vpng.VpnGatewayId, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSVpnGateway_basic(t *testing.T) {
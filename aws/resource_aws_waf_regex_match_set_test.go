@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -27,27 +28,43 @@ func testSweepWafRegexMatchSet(region string) error {
 	}
 	conn := client.(*AWSClient).wafconn
 
+	var sets []*waf.RegexMatchSetSummary
 	req := &waf.ListRegexMatchSetsInput{}
-	resp, err := conn.ListRegexMatchSets(req)
-	if err != nil {
-		return fmt.Errorf("Error describing WAF Regex Match Sets: %s", err)
+	for {
+		resp, err := conn.ListRegexMatchSets(req)
+		if err != nil {
+			return fmt.Errorf("Error describing WAF Regex Match Sets: %s", err)
+		}
+		sets = append(sets, resp.RegexMatchSets...)
+
+		if resp.NextMarker == nil {
+			break
+		}
+		req.NextMarker = resp.NextMarker
 	}
 
-	if len(resp.RegexMatchSets) == 0 {
+	if len(sets) == 0 {
 		log.Print("[DEBUG] No AWS WAF Regex Match Sets to sweep")
 		return nil
 	}
 
-	for _, s := range resp.RegexMatchSets {
+	var errors error
+	for _, s := range sets {
 		if !strings.HasPrefix(*s.Name, "tfacc") {
 			continue
 		}
 
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete WAF Regex Match Set: %s", *s.RegexMatchSetId)
+			continue
+		}
+
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: s.RegexMatchSetId,
 		})
 		if err != nil {
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error reading WAF Regex Match Set %s: %s", *s.RegexMatchSetId, err))
+			continue
 		}
 		set := resp.RegexMatchSet
 
@@ -55,7 +72,8 @@ func testSweepWafRegexMatchSet(region string) error {
 		noTuples := []interface{}{}
 		err = updateRegexMatchSetResource(*set.RegexMatchSetId, oldTuples, noTuples, conn)
 		if err != nil {
-			return fmt.Errorf("Error updating WAF Regex Match Set: %s", err)
+			errors = multierror.Append(errors, fmt.Errorf("Error updating WAF Regex Match Set %s: %s", *set.RegexMatchSetId, err))
+			continue
 		}
 
 		wr := newWafRetryer(conn, "global")
@@ -67,9 +85,12 @@ func testSweepWafRegexMatchSet(region string) error {
 			log.Printf("[INFO] Deleting WAF Regex Match Set: %s", req)
 			return conn.DeleteRegexMatchSet(req)
 		})
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting WAF Regex Match Set %s: %s", *set.RegexMatchSetId, err))
+		}
 	}
 
-	return nil
+	return errors
 }
 
 // Serialized acceptance tests due to WAF account limits
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -28,36 +29,67 @@ func testSweepWafRuleGroups(region string) error {
 	}
 	conn := client.(*AWSClient).wafconn
 
+	var groups []*waf.RuleGroupSummary
 	req := &waf.ListRuleGroupsInput{}
-	resp, err := conn.ListRuleGroups(req)
-	if err != nil {
-		return fmt.Errorf("Error describing WAF Rule Groups: %s", err)
+	for {
+		resp, err := conn.ListRuleGroups(req)
+		if err != nil {
+			return fmt.Errorf("Error describing WAF Rule Groups: %s", err)
+		}
+		groups = append(groups, resp.RuleGroups...)
+
+		if resp.NextMarker == nil {
+			break
+		}
+		req.NextMarker = resp.NextMarker
 	}
 
-	if len(resp.RuleGroups) == 0 {
+	if len(groups) == 0 {
 		log.Print("[DEBUG] No AWS WAF Rule Groups to sweep")
 		return nil
 	}
 
-	for _, group := range resp.RuleGroups {
+	var errors error
+	for _, group := range groups {
 		if !strings.HasPrefix(*group.Name, "tfacc") {
 			continue
 		}
 
-		rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete WAF Rule Group: %s", *group.RuleGroupId)
+			continue
+		}
+
+		var activatedRules []*waf.ActivatedRule
+		rReq := &waf.ListActivatedRulesInRuleGroupInput{
 			RuleGroupId: group.RuleGroupId,
-		})
+		}
+		for {
+			var rResp *waf.ListActivatedRulesInRuleGroupOutput
+			rResp, err = conn.ListActivatedRulesInRuleGroup(rReq)
+			if err != nil {
+				break
+			}
+			activatedRules = append(activatedRules, rResp.ActivatedRules...)
+
+			if rResp.NextMarker == nil {
+				break
+			}
+			rReq.NextMarker = rResp.NextMarker
+		}
 		if err != nil {
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error listing rules of WAF Rule Group %s: %s", *group.RuleGroupId, err))
+			continue
 		}
-		oldRules := flattenWafActivatedRules(rResp.ActivatedRules)
+
+		oldRules := flattenWafActivatedRules(activatedRules)
 		err = deleteWafRuleGroup(*group.RuleGroupId, oldRules, conn)
 		if err != nil {
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting WAF Rule Group %s: %s", *group.RuleGroupId, err))
 		}
 	}
 
-	return nil
+	return errors
 }
 
 func TestAccAWSWafRuleGroup_basic(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -28,27 +29,43 @@ func testSweepWafRegionalRegexMatchSet(region string) error {
 	}
 	conn := client.(*AWSClient).wafregionalconn
 
+	var sets []*waf.RegexMatchSetSummary
 	req := &waf.ListRegexMatchSetsInput{}
-	resp, err := conn.ListRegexMatchSets(req)
-	if err != nil {
-		return fmt.Errorf("Error describing WAF Regional Regex Match Sets: %s", err)
+	for {
+		resp, err := conn.ListRegexMatchSets(req)
+		if err != nil {
+			return fmt.Errorf("Error describing WAF Regional Regex Match Sets: %s", err)
+		}
+		sets = append(sets, resp.RegexMatchSets...)
+
+		if resp.NextMarker == nil {
+			break
+		}
+		req.NextMarker = resp.NextMarker
 	}
 
-	if len(resp.RegexMatchSets) == 0 {
+	if len(sets) == 0 {
 		log.Print("[DEBUG] No AWS WAF Regional Regex Match Sets to sweep")
 		return nil
 	}
 
-	for _, s := range resp.RegexMatchSets {
+	var errors error
+	for _, s := range sets {
 		if !strings.HasPrefix(*s.Name, "tfacc") {
 			continue
 		}
 
+		if testSweepDryRun() {
+			log.Printf("[INFO] Would delete WAF Regional Regex Match Set: %s", *s.RegexMatchSetId)
+			continue
+		}
+
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: s.RegexMatchSetId,
 		})
 		if err != nil {
-			return err
+			errors = multierror.Append(errors, fmt.Errorf("Error reading WAF Regional Regex Match Set %s: %s", *s.RegexMatchSetId, err))
+			continue
 		}
 		set := resp.RegexMatchSet
 
@@ -56,7 +73,8 @@ func testSweepWafRegionalRegexMatchSet(region string) error {
 		noTuples := []interface{}{}
 		err = updateRegexMatchSetResourceWR(*set.RegexMatchSetId, oldTuples, noTuples, conn, region)
 		if err != nil {
-			return fmt.Errorf("Error updating WAF Regional Regex Match Set: %s", err)
+			errors = multierror.Append(errors, fmt.Errorf("Error updating WAF Regional Regex Match Set %s: %s", *set.RegexMatchSetId, err))
+			continue
 		}
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -68,9 +86,12 @@ func testSweepWafRegionalRegexMatchSet(region string) error {
 			log.Printf("[INFO] Deleting WAF Regional Regex Match Set: %s", req)
 			return conn.DeleteRegexMatchSet(req)
 		})
+		if err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("Error deleting WAF Regional Regex Match Set %s: %s", *set.RegexMatchSetId, err))
+		}
 	}
 
-	return nil
+	return errors
 }
 
 // Serialized acceptance tests due to WAF account limits
@@ -25,9 +25,10 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"resource_arn": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArnServiceResource("elasticloadbalancing", "loadbalancer/app/"),
 			},
 		},
 	}
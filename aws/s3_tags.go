@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -47,6 +49,16 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 				return err
 			}
 		}
+
+		expected := tagsToMapS3(tagsFromMapS3(n))
+		_, err := retryOnS3ReadAfterWrite(s3ReadAfterWriteTimeout, nil, func() (interface{}, error) {
+			return getTagSetS3(conn, d.Get("bucket").(string))
+		}, func(out interface{}) bool {
+			return reflect.DeepEqual(tagsToMapS3(out.([]*s3.Tag)), expected)
+		})
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 bucket tags: %s", err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,6 @@
+exports.handler = function(event, context, callback) {
+    if (process.env.TEST_DATA) {
+        event.key3 = process.env.TEST_DATA;
+    }
+    callback(null, event);
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -10,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
@@ -400,6 +402,144 @@ func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validateArnServiceResource returns a SchemaValidateFunc which checks that
+// the value is an ARN for the given service and, if any resource prefixes are
+// given, that the resource part of the ARN starts with one of them.
+func validateArnServiceResource(service string, resourcePrefixes ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if value == "" {
+			return
+		}
+
+		ws, errors = validateArn(v, k)
+		if len(errors) > 0 {
+			return
+		}
+
+		parsedArn, err := arn.Parse(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q doesn't look like a valid ARN: %s", k, err))
+			return
+		}
+
+		if parsedArn.Service != service {
+			errors = append(errors, fmt.Errorf(
+				"%q must be an ARN for the %q service, got %q: %q",
+				k, service, parsedArn.Service, value))
+			return
+		}
+
+		if len(resourcePrefixes) == 0 {
+			return
+		}
+
+		for _, prefix := range resourcePrefixes {
+			if strings.HasPrefix(parsedArn.Resource, prefix) {
+				return
+			}
+		}
+
+		errors = append(errors, fmt.Errorf(
+			"%q must be an ARN for a %s resource (%s), got %q: %q",
+			k, service, strings.Join(resourcePrefixes, ", "), parsedArn.Resource, value))
+		return
+	}
+}
+
+// validateArnService returns a SchemaValidateFunc which checks that the value
+// is an ARN for one of the given services, for arguments which accept more
+// than one kind of resource.
+func validateArnService(services ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if value == "" {
+			return
+		}
+
+		ws, errors = validateArn(v, k)
+		if len(errors) > 0 {
+			return
+		}
+
+		parsedArn, err := arn.Parse(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q doesn't look like a valid ARN: %s", k, err))
+			return
+		}
+
+		for _, service := range services {
+			if parsedArn.Service == service {
+				return
+			}
+		}
+
+		errors = append(errors, fmt.Errorf(
+			"%q must be an ARN for one of the %s services, got %q: %q",
+			k, strings.Join(services, ", "), parsedArn.Service, value))
+		return
+	}
+}
+
+// validateAll returns a SchemaValidateFunc which runs each of the given
+// validators and returns all of their warnings and errors.
+func validateAll(validators ...schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		for _, validator := range validators {
+			w, e := validator(v, k)
+			ws = append(ws, w...)
+			errors = append(errors, e...)
+		}
+		return
+	}
+}
+
+func validateIamRoleArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("iam", "role/")(v, k)
+}
+
+func validateIamInstanceProfileArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("iam", "instance-profile/")(v, k)
+}
+
+func validateKmsKeyArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("kms", "key/", "alias/")(v, k)
+}
+
+func validateIamPolicyArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("iam", "policy/")(v, k)
+}
+
+func validateAcmCertificateArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("acm", "certificate/")(v, k)
+}
+
+func validateCloudWatchLogGroupArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("logs", "log-group:")(v, k)
+}
+
+func validateLambdaFunctionArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("lambda", "function:")(v, k)
+}
+
+func validateLbListenerArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("elasticloadbalancing", "listener/")(v, k)
+}
+
+func validateLbTargetGroupArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("elasticloadbalancing", "targetgroup/")(v, k)
+}
+
+func validateS3Arn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("s3")(v, k)
+}
+
+func validateSnsTopicArn(v interface{}, k string) (ws []string, errors []error) {
+	return validateArnServiceResource("sns")(v, k)
+}
+
 func validatePolicyStatementId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -541,10 +681,29 @@ func validateS3BucketLifecycleTimestamp(v interface{}, k string) (ws []string, e
 	return
 }
 
+// S3 storage classes not yet defined by the vendored aws-sdk-go.
+const (
+	s3StorageClassIntelligentTiering = "INTELLIGENT_TIERING"
+	s3StorageClassDeepArchive        = "DEEP_ARCHIVE"
+)
+
 func validateS3BucketLifecycleStorageClass() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		s3.TransitionStorageClassStandardIa,
+		s3StorageClassIntelligentTiering,
 		s3.TransitionStorageClassGlacier,
+		s3StorageClassDeepArchive,
+	}, false)
+}
+
+func validateS3ObjectStorageClass() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		s3.StorageClassStandard,
+		s3.StorageClassReducedRedundancy,
+		s3.StorageClassStandardIa,
+		s3StorageClassIntelligentTiering,
+		s3.ObjectStorageClassGlacier,
+		s3StorageClassDeepArchive,
 	}, false)
 }
 
@@ -581,10 +740,123 @@ func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error
 	}
 	if _, err := structure.NormalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	errors = append(errors, validatePolicyDocumentStructure(value, k)...)
+	return
+}
+
+// validateAwsPolicyJson validates resource-based policy documents (S3, SQS,
+// SNS, KMS, IAM trust policies), which must be valid JSON and contain
+// well-formed statements.
+func validateAwsPolicyJson(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	if _, err := structure.NormalizeJsonString(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
 	}
+	errors = append(errors, validatePolicyDocumentStructure(value, k)...)
 	return
 }
 
+// validatePolicyDocumentStructure checks the statements of a policy
+// document against the AWS policy grammar. Documents without a Statement
+// element are left to the service to reject.
+func validatePolicyDocumentStructure(value, k string) (errors []error) {
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		return []error{fmt.Errorf("%q must be a JSON object: %s", k, err)}
+	}
+
+	raw, ok := policy["Statement"]
+	if !ok {
+		return nil
+	}
+
+	var statements []interface{}
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	case []interface{}:
+		statements = v
+	default:
+		return []error{fmt.Errorf("%q: Statement must be an object or a list of objects", k)}
+	}
+
+	for i, raw := range statements {
+		statement, ok := raw.(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q: Statement %d must be an object", k, i))
+			continue
+		}
+
+		switch effect := statement["Effect"]; effect {
+		case "Allow", "Deny":
+		case nil:
+			errors = append(errors, fmt.Errorf("%q: Statement %d must contain an Effect", k, i))
+		default:
+			errors = append(errors, fmt.Errorf("%q: Statement %d has an invalid Effect (%v), expected \"Allow\" or \"Deny\"", k, i, effect))
+		}
+
+		for _, pair := range [][2]string{{"Action", "NotAction"}, {"Principal", "NotPrincipal"}, {"Resource", "NotResource"}} {
+			_, has := statement[pair[0]]
+			_, hasNot := statement[pair[1]]
+			if has && hasNot {
+				errors = append(errors, fmt.Errorf("%q: Statement %d cannot contain both %s and %s", k, i, pair[0], pair[1]))
+			}
+			if pair[0] == "Action" && !has && !hasNot {
+				errors = append(errors, fmt.Errorf("%q: Statement %d must contain an Action or NotAction", k, i))
+			}
+		}
+
+		for _, element := range []string{"Principal", "NotPrincipal"} {
+			if principal, ok := statement[element]; ok {
+				if err := validatePolicyPrincipal(principal); err != nil {
+					errors = append(errors, fmt.Errorf("%q: Statement %d has an invalid %s: %s", k, i, element, err))
+				}
+			}
+		}
+	}
+
+	return errors
+}
+
+func validatePolicyPrincipal(principal interface{}) error {
+	switch v := principal.(type) {
+	case string:
+		if v != "*" {
+			return fmt.Errorf("expected \"*\" or an object, got %q", v)
+		}
+	case map[string]interface{}:
+		for principalType, ids := range v {
+			switch principalType {
+			case "AWS", "CanonicalUser", "Federated", "Service":
+			default:
+				return fmt.Errorf("unsupported principal type %q", principalType)
+			}
+
+			switch ids := ids.(type) {
+			case string:
+			case []interface{}:
+				for _, id := range ids {
+					if _, ok := id.(string); !ok {
+						return fmt.Errorf("%s principals must be strings", principalType)
+					}
+				}
+			default:
+				return fmt.Errorf("%s principals must be a string or a list of strings", principalType)
+			}
+		}
+	default:
+		return fmt.Errorf("expected \"*\" or an object")
+	}
+
+	return nil
+}
+
 func validateCloudFormationTemplate(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJsonString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
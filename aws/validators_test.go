@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestValidateRFC3339TimeString(t *testing.T) {
@@ -332,6 +333,52 @@ func TestValidateArn(t *testing.T) {
 	}
 }
 
+func TestValidateArnServiceResource(t *testing.T) {
+	cases := []struct {
+		Name       string
+		ValidateFn schema.SchemaValidateFunc
+		Value      string
+		ErrCount   int
+	}{
+		{"empty", validateIamRoleArn, "", 0},
+		{"role", validateIamRoleArn, "arn:aws:iam::123456789012:role/example", 0},
+		{"role with path", validateIamRoleArn, "arn:aws:iam::123456789012:role/service-role/example", 0},
+		{"govcloud role", validateIamRoleArn, "arn:aws-us-gov:iam::123456789012:role/example", 0},
+		{"instance profile as role", validateIamRoleArn, "arn:aws:iam::123456789012:instance-profile/example", 1},
+		{"user as role", validateIamRoleArn, "arn:aws:iam::123456789012:user/example", 1},
+		{"role name", validateIamRoleArn, "example", 1},
+		{"instance profile", validateIamInstanceProfileArn, "arn:aws:iam::123456789012:instance-profile/example", 0},
+		{"role as instance profile", validateIamInstanceProfileArn, "arn:aws:iam::123456789012:role/example", 1},
+		{"kms key", validateKmsKeyArn, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", 0},
+		{"kms alias", validateKmsKeyArn, "arn:aws:kms:us-west-2:123456789012:alias/example", 0},
+		{"kms key id", validateKmsKeyArn, "1234abcd-12ab-34cd-56ef-1234567890ab", 1},
+		{"iam role as kms key", validateKmsKeyArn, "arn:aws:iam::123456789012:role/example", 1},
+		{"any sns resource", validateArnServiceResource("sns"), "arn:aws:sns:us-west-2:123456789012:example", 0},
+		{"sqs as sns", validateArnServiceResource("sns"), "arn:aws:sqs:us-west-2:123456789012:example", 1},
+		{"sns of sns or sqs", validateArnService("sns", "sqs"), "arn:aws:sns:us-west-2:123456789012:example", 0},
+		{"sqs of sns or sqs", validateArnService("sns", "sqs"), "arn:aws:sqs:us-west-2:123456789012:example", 0},
+		{"lambda of sns or sqs", validateArnService("sns", "sqs"), "arn:aws:lambda:us-west-2:123456789012:function:example", 1},
+		{"not an arn of sns or sqs", validateArnService("sns", "sqs"), "example", 1},
+		{"lambda function", validateLambdaFunctionArn, "arn:aws:lambda:us-west-2:123456789012:function:example", 0},
+		{"qualified lambda function", validateLambdaFunctionArn, "arn:aws:lambda:us-west-2:123456789012:function:example:1", 0},
+		{"lambda layer as function", validateLambdaFunctionArn, "arn:aws:lambda:us-west-2:123456789012:layer:example", 1},
+		{"target group", validateLbTargetGroupArn, "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/example/0123456789abcdef", 0},
+		{"load balancer as target group", validateLbTargetGroupArn, "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/example/0123456789abcdef", 1},
+		{"log group", validateCloudWatchLogGroupArn, "arn:aws:logs:us-west-2:123456789012:log-group:example:*", 0},
+		{"log stream as log group", validateCloudWatchLogGroupArn, "arn:aws:logs:us-west-2:123456789012:destination:example", 1},
+		{"role within length", validateAll(validateIamRoleArn, validateMaxLength(40)), "arn:aws:iam::123456789012:role/example", 0},
+		{"too long role", validateAll(validateIamRoleArn, validateMaxLength(30)), "arn:aws:iam::123456789012:role/example", 1},
+		{"too long user as role", validateAll(validateIamRoleArn, validateMaxLength(30)), "arn:aws:iam::123456789012:user/example", 2},
+	}
+
+	for _, tc := range cases {
+		_, errors := tc.ValidateFn(tc.Value, "arn")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("%s: expected %d errors for %q, got %d: %q", tc.Name, tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
 func TestValidatePolicyStatementId(t *testing.T) {
 	validNames := []string{
 		"YadaHereAndThere",
@@ -729,6 +776,11 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 		},
 	}
 
+	invalidCases = append(invalidCases, testCases{
+		Value:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+		ErrCount: 1,
+	})
+
 	for _, tc := range invalidCases {
 		_, errors := validateIAMPolicyJson(tc.Value, "json")
 		if len(errors) != tc.ErrCount {
@@ -755,6 +807,48 @@ func TestValidateIAMPolicyJsonString(t *testing.T) {
 	}
 }
 
+func TestValidateAwsPolicyJson(t *testing.T) {
+	validCases := []string{
+		``,
+		`{}`,
+		` {"Version":"2012-10-17"}`,
+		`{"Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+		`{"Statement":[{"Effect":"Deny","NotAction":["s3:*"],"NotResource":"*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","123456789012"]},"Action":"kms:*","Resource":"*"}]}`,
+	}
+
+	for _, v := range validCases {
+		_, errors := validateAwsPolicyJson(v, "policy")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid policy: %q", v, errors)
+		}
+	}
+
+	invalidCases := []string{
+		`{"abc":"`,
+		`["Statement"]`,
+		`{"Statement":"Allow"}`,
+		`{"Statement":["Allow"]}`,
+		`{"Statement":[{"Action":"s3:*","Resource":"*"}]}`,
+		`{"Statement":[{"Effect":"allow","Action":"s3:*","Resource":"*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"s3:*","NotAction":"s3:GetObject","Resource":"*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","NotResource":"*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":"arn:aws:iam::123456789012:root","Action":"s3:*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":{"User":"123456789012"},"Action":"s3:*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Principal":{"AWS":[123456789012]},"Action":"s3:*"}]}`,
+	}
+
+	for _, v := range invalidCases {
+		_, errors := validateAwsPolicyJson(v, "policy")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid policy", v)
+		}
+	}
+}
+
 func TestValidateCloudFormationTemplate(t *testing.T) {
 	type testCases struct {
 		Value    string